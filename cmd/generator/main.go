@@ -1,33 +1,77 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"github.com/spf13/viper"
 	"google.golang.org/grpc"
+	channelzservice "google.golang.org/grpc/channelz/service"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"log"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
 	"strconv"
-	"sync"
 	"syscall"
 	"telematics-generator/pkg/cache"
+	"telematics-generator/pkg/clock"
+	"telematics-generator/pkg/control"
+	"telematics-generator/pkg/fleet"
+	"telematics-generator/pkg/gateway"
 	"telematics-generator/pkg/generator"
 	mygrpc "telematics-generator/pkg/grpc"
 	"telematics-generator/pkg/kafka"
 	"telematics-generator/pkg/models"
+	"telematics-generator/pkg/pubsub"
+	"telematics-generator/pkg/track"
 	"telematics-generator/protobuf"
 	"time"
 )
 
+// maxVehicles limits the vehicles running at once, including the ones added
+// through the control service.
+const maxVehicles = 100
+
+// httpShutdownTimeout limits waiting for HTTP requests in progress, e.g. live
+// subscriptions, on shutdown.
+const httpShutdownTimeout = 5 * time.Second
+
 type AppConfig struct {
 	VehiclesCount int
+	Fleet         []fleet.Profile
 	MaxSpeed      int
 	MaxTimeStep   int
+	ClockTick     time.Duration
+	ClockFactor   float64
 	CacheSize     int
+	VehicleCache  int
+	CacheShards   int
+	CacheMaxAge   time.Duration
+	CacheMaxSize  int64
+	ExpiryEvery   time.Duration
+	StatsEvery    time.Duration
 	BrokerHost    string
 	TopicName     string
+	TripsTopic    string
+	CommandsTopic string
+	Latency       time.Duration
+	Trips         track.TripOptions
+	Storage       string
+	StorageDir    string
+	Retention     time.Duration
+	StorageMax    int64
 	GrpsPort      int
+	HttpPort      int
+	HealthEvery   time.Duration
+	StateFile     string
+	StateInterval time.Duration
+	FreshStart    bool
+	WarmStart     string
+	SnapshotFile  string
+	WarmWindow    time.Duration
 }
 
 func main() {
@@ -38,23 +82,117 @@ func main() {
 
 	log.Println("Initializing Kafka producer")
 	producer := kafka.NewKafkaProducer([]string{config.BrokerHost}, config.TopicName)
+	tripProducer := kafka.NewKeyedKafkaProducer([]string{config.BrokerHost}, config.TripsTopic)
+	ackProducer := kafka.NewKeyedKafkaProducer([]string{config.BrokerHost}, config.CommandsTopic)
+	trips := track.NewTripDetector(config.Trips)
 
 	log.Println("Initializing data generator")
-	gen := generator.NewRandomTelematicsGenerator(config.MaxSpeed, config.MaxTimeStep)
+	simClock, err := clock.New(config.ClockTick, config.ClockFactor)
+	if err != nil {
+		log.Fatalf("Failed to create simulation clock: %v", err)
+	}
+	gen := generator.NewRandomTelematicsGenerator(config.MaxSpeed, config.MaxTimeStep, generator.WithClock(simClock))
+
+	vehicleFleet := fleet.New(config.Fleet, config.VehiclesCount)
+
+	if config.StateFile == "" {
+		log.Println("Generator state is not saved, stateFile is not set")
+	} else if config.FreshStart {
+		log.Println("Fresh start requested, saved generator state is ignored")
+	} else {
+		states, err := generator.LoadState(config.StateFile)
+		if err != nil {
+			log.Printf("Failed to load generator state, starting fresh: %v", err)
+		} else {
+			gen.Restore(states)
+			log.Printf("Restored generator state of %d vehicles", len(states))
+		}
+	}
 
-	log.Println("Initializing data cache")
-	telematicsDataCache := cache.NewTelematicsDataCache(config.CacheSize)
+	var telematicsDataCache cache.DataCacher
+	var memoryCache *cache.TelematicsDataCache
+	switch config.Storage {
+	case "disk":
+		log.Println("Opening disk storage")
+		diskCache, err := cache.OpenDiskCache(config.StorageDir, config.Retention, config.StorageMax,
+			cache.WithDiskClock(simClock.Now))
+		if err != nil {
+			log.Fatalf("Failed to open disk storage: %v", err)
+		}
+		defer func() {
+			if err := diskCache.Close(); err != nil {
+				log.Printf("Failed to close disk storage: %v", err)
+			}
+		}()
+		telematicsDataCache = diskCache
+	default:
+		log.Println("Initializing data cache")
+		memoryCache = cache.NewTelematicsDataCache(config.CacheSize,
+			cache.WithVehicleCapacity(config.VehicleCache),
+			cache.WithMaxAge(config.CacheMaxAge),
+			cache.WithMaxBytes(config.CacheMaxSize),
+			cache.WithShards(config.CacheShards),
+			cache.WithClock(simClock.Now))
+		warmStart(memoryCache, config)
+		telematicsDataCache = memoryCache
+	}
+
+	stopExpiry := make(chan struct{})
+	if expirer, ok := telematicsDataCache.(cache.Expirer); ok {
+		go cache.RunExpiry(expirer, simClock.Now, config.ExpiryEvery, stopExpiry)
+	}
+	stopStats := make(chan struct{})
+	if memoryCache != nil {
+		go logCacheStats(memoryCache, config.StatsEvery, stopStats)
+	}
+
+	hub := pubsub.NewHub()
+	controller := control.New(gen, func(telematicsData models.TelematicsData) {
+		telematicsDataCache.Add(telematicsData)
+		hub.Publish(telematicsData)
+
+		if err := producer.ProduceMessage(convertToProto(telematicsData)); err != nil {
+			log.Printf("Failed to produce message: %v", err)
+		}
+
+		if event, ok := trips.Add(telematicsData); ok {
+			if err := tripProducer.ProduceTripEvent(convertTripEventToProto(event)); err != nil {
+				log.Printf("Failed to produce trip event: %v", err)
+			}
+		}
+	}, maxVehicles, control.WithCommandLatency(config.Latency), control.WithAckSink(func(ack control.Ack) {
+		if err := ackProducer.ProduceCommandAck(convertCommandAckToProto(ack)); err != nil {
+			log.Printf("Failed to produce command ack: %v", err)
+		}
+	}))
 
 	log.Println("Initializing GRPC server")
-	s := mygrpc.NewServer(telematicsDataCache)
+	s := mygrpc.NewServer(telematicsDataCache, mygrpc.WithHub(hub), mygrpc.WithFleet(vehicleFleet),
+		mygrpc.WithTripOptions(config.Trips), mygrpc.WithClock(simClock))
 
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", config.GrpsPort))
 	if err != nil {
 		log.Fatalf("Failed to listen: %v", err)
 	}
 
-	grpcServer := grpc.NewServer()
+	// Interceptors, e.g. for authentication or logging, are shared by the gRPC
+	// server and the HTTP gateway.
+	var unaryInterceptors []grpc.UnaryServerInterceptor
+	var streamInterceptors []grpc.StreamServerInterceptor
+	grpcServer := grpc.NewServer(grpc.ChainUnaryInterceptor(unaryInterceptors...), grpc.ChainStreamInterceptor(streamInterceptors...))
 	protobuf.RegisterTelematicsDataServiceServer(grpcServer, s)
+	protobuf.RegisterControlServiceServer(grpcServer, mygrpc.NewControlServer(controller, vehicleFleet))
+
+	health := mygrpc.NewHealth([]string{
+		protobuf.TelematicsDataService_ServiceDesc.ServiceName,
+		protobuf.ControlService_ServiceDesc.ServiceName,
+	}, producer.Err, tripProducer.Err, ackProducer.Err, controller.Err)
+	healthpb.RegisterHealthServer(grpcServer, health.Server())
+	reflection.Register(grpcServer)
+	channelzservice.RegisterChannelzServiceToServer(grpcServer)
+
+	stopHealth := make(chan struct{})
+	go health.Run(config.HealthEvery, stopHealth)
 
 	go func() {
 		log.Println("Starting GRPC server")
@@ -63,56 +201,152 @@ func main() {
 		}
 	}()
 
-	var wg sync.WaitGroup
+	httpServer := &http.Server{
+		Addr: fmt.Sprintf(":%d", config.HttpPort),
+		Handler: gateway.New([]gateway.Service{{
+			Desc: &protobuf.TelematicsDataService_ServiceDesc,
+			Impl: s,
+		}}, gateway.WithUnaryInterceptors(unaryInterceptors...), gateway.WithStreamInterceptors(streamInterceptors...)),
+	}
+	go func() {
+		log.Println("Starting HTTP gateway")
+		if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			log.Fatalf("Failed to serve HTTP: %v", err)
+		}
+	}()
 
 	log.Println("Starting data generation")
-	stops := make([]chan struct{}, config.VehiclesCount)
 	for i := 1; i < config.VehiclesCount+1; i++ {
-		wg.Add(1)
-		stops[i-1] = make(chan struct{})
+		if _, err := controller.Add(i, 0, 0); err != nil {
+			log.Fatalf("Failed to start vehicle %d: %v", i, err)
+		}
+	}
 
-		go func(stop chan struct{}, id int) {
-			defer wg.Done()
+	stopSaving := make(chan struct{})
+	savingDone := make(chan struct{})
+	go func() {
+		defer close(savingDone)
 
-			for telematicsData := range gen.Generate(id, stop) {
-				telematicsDataCache.Add(telematicsData)
+		if config.StateFile == "" {
+			return
+		}
 
-				protoData := convertToProto(telematicsData)
+		ticker := time.NewTicker(config.StateInterval)
+		defer ticker.Stop()
 
-				err = producer.ProduceMessage(protoData)
-				if err != nil {
-					log.Printf("Failed to produce message: %v", err)
+		for {
+			select {
+			case <-stopSaving:
+				return
+			case <-ticker.C:
+				if err := generator.SaveState(config.StateFile, gen.Snapshot()); err != nil {
+					log.Printf("Failed to save generator state: %v", err)
 				}
 			}
-		}(stops[i-1], i)
-	}
+		}
+	}()
 
 	c := make(chan os.Signal, 1)
 	signal.Notify(c, syscall.SIGINT, syscall.SIGTERM)
 
 	<-c
 
+	log.Println("Shutting down, services are not serving")
+	health.Shutdown()
+	close(stopHealth)
+
 	log.Println("Stopping generators")
-	for _, stop := range stops {
-		close(stop)
+	controller.Stop()
+	log.Println("Data generation completed")
+
+	if memoryCache != nil && config.WarmStart == "snapshot" {
+		records := memoryCache.Records()
+		if err := cache.SaveSnapshot(config.SnapshotFile, records); err != nil {
+			log.Printf("Failed to save cache snapshot: %v", err)
+		} else {
+			log.Printf("Saved cache snapshot of %d records", len(records))
+		}
 	}
 
-	wg.Wait()
-	log.Println("Data generation completed")
+	close(stopExpiry)
+	close(stopStats)
+	close(stopSaving)
+	<-savingDone
+	if config.StateFile != "" {
+		if err := generator.SaveState(config.StateFile, gen.Snapshot()); err != nil {
+			log.Printf("Failed to save generator state: %v", err)
+		}
+	}
 
 	err = producer.Close()
 	if err != nil {
 		log.Printf("Failed to close producer: %v", err)
 	}
+	if err := tripProducer.Close(); err != nil {
+		log.Printf("Failed to close trip producer: %v", err)
+	}
+	if err := ackProducer.Close(); err != nil {
+		log.Printf("Failed to close command ack producer: %v", err)
+	}
+
+	log.Println("Stopping HTTP gateway")
+	ctx, cancel := context.WithTimeout(context.Background(), httpShutdownTimeout)
+	if err := httpServer.Shutdown(ctx); err != nil {
+		log.Printf("Failed to stop HTTP gateway gracefully: %v", err)
+		httpServer.Close()
+	}
+	cancel()
 
 	log.Println("Stopping GRPC server")
 	grpcServer.GracefulStop()
 }
 
+// logCacheStats logs the size and the evictions of the cache every interval
+// until stop is closed.
+func logCacheStats(c *cache.TelematicsDataCache, interval time.Duration, stop chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			stats := c.Stats()
+			log.Printf("Cache holds %d of %d records of %d vehicles (about %d bytes), evicted %d by cache capacity, %d by vehicle capacity and %d by age",
+				stats.Len, stats.Capacity, stats.Vehicles, stats.Bytes, stats.CapacityEvictions, stats.VehicleEvictions, stats.Expirations)
+		}
+	}
+}
+
 func loadConfig(configPath string) (*AppConfig, error) {
 	viper.SetConfigFile(configPath)
 	viper.SetConfigType("yaml")
 
+	// Keys added after the first release default to the old behaviour, so
+	// older configuration files keep working.
+	viper.SetDefault("fleetProfiles", []fleet.Profile{fleet.DefaultProfile})
+	viper.SetDefault("clockTick", "1s")
+	viper.SetDefault("clockFactor", 1)
+	viper.SetDefault("cacheShards", 1)
+	viper.SetDefault("cacheMaxAge", "0s")
+	viper.SetDefault("cacheMaxSize", "0")
+	viper.SetDefault("expiryInterval", "10s")
+	viper.SetDefault("statsInterval", "1m")
+	viper.SetDefault("storage", "memory")
+	viper.SetDefault("storageRetention", "0s")
+	viper.SetDefault("storageMaxSize", "0")
+	viper.SetDefault("tripsTopicName", "trips")
+	viper.SetDefault("commandsTopicName", "commands")
+	viper.SetDefault("commandLatency", "2s")
+	viper.SetDefault("tripIdleSpeed", track.DefaultTripOptions.IdleSpeed)
+	viper.SetDefault("tripMinStop", track.DefaultTripOptions.MinStop.String())
+	viper.SetDefault("httpPort", 8080)
+	viper.SetDefault("healthInterval", "5s")
+	viper.SetDefault("stateInterval", "10s")
+	viper.SetDefault("warmStart", "none")
+	viper.SetDefault("warmStartWindow", "30m")
+
 	if err := viper.ReadInConfig(); err != nil {
 		return nil, fmt.Errorf("failed to read the configuration file: %w", err)
 	}
@@ -122,13 +356,21 @@ func loadConfig(configPath string) (*AppConfig, error) {
 	if err != nil {
 		return nil, fmt.Errorf("vehiclesCount should be an integer: %w", err)
 	}
-	if vehiclesCount > 100 {
-		return nil, fmt.Errorf("vehiclesCount should be less than 100")
+	if vehiclesCount > maxVehicles {
+		return nil, fmt.Errorf("vehiclesCount should be less than %d", maxVehicles)
 	}
 	if vehiclesCount < 1 {
 		return nil, fmt.Errorf("vehiclesCount should be more than 1")
 	}
 
+	var profiles []fleet.Profile
+	if err := viper.UnmarshalKey("fleetProfiles", &profiles); err != nil {
+		return nil, fmt.Errorf("invalid fleetProfiles format: %w", err)
+	}
+	if err := fleet.Validate(profiles); err != nil {
+		return nil, fmt.Errorf("invalid fleetProfiles: %w", err)
+	}
+
 	maxSpeedStr := viper.GetString("maxSpeed")
 	maxSpeed, err := strconv.Atoi(maxSpeedStr)
 	if err != nil {
@@ -150,6 +392,30 @@ func loadConfig(configPath string) (*AppConfig, error) {
 		return nil, fmt.Errorf("maxTimeStep should be less than 24h")
 	}
 
+	clockTickStr := viper.GetString("clockTick")
+	clockTick, err := time.ParseDuration(clockTickStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid clockTick format: %w", err)
+	}
+	if clockTick < time.Millisecond {
+		return nil, fmt.Errorf("clockTick should be more than 1ms")
+	}
+	if clockTick > time.Hour {
+		return nil, fmt.Errorf("clockTick should be less than 1h")
+	}
+
+	clockFactorStr := viper.GetString("clockFactor")
+	clockFactor, err := strconv.ParseFloat(clockFactorStr, 64)
+	if err != nil {
+		return nil, fmt.Errorf("clockFactor should be a number: %w", err)
+	}
+	if clockFactor <= 0 {
+		return nil, fmt.Errorf("clockFactor should be more than 0")
+	}
+	if clockFactor > 10000 {
+		return nil, fmt.Errorf("clockFactor should be less than 10000")
+	}
+
 	cacheSizeStr := viper.GetString("cacheSize")
 	cacheSize, err := strconv.Atoi(cacheSizeStr)
 	if err != nil {
@@ -162,6 +428,84 @@ func loadConfig(configPath string) (*AppConfig, error) {
 		return nil, fmt.Errorf("cacheSize should be less than 1 000 000")
 	}
 
+	viper.SetDefault("vehicleCacheSize", cacheSize)
+	vehicleCacheSizeStr := viper.GetString("vehicleCacheSize")
+	vehicleCacheSize, err := strconv.Atoi(vehicleCacheSizeStr)
+	if err != nil {
+		return nil, fmt.Errorf("vehicleCacheSize should be an integer: %w", err)
+	}
+	if vehicleCacheSize < 1 {
+		return nil, fmt.Errorf("vehicleCacheSize should be more than 1")
+	}
+	if vehicleCacheSize > cacheSize {
+		return nil, fmt.Errorf("vehicleCacheSize should be less than cacheSize")
+	}
+
+	cacheShardsStr := viper.GetString("cacheShards")
+	cacheShards, err := strconv.Atoi(cacheShardsStr)
+	if err != nil {
+		return nil, fmt.Errorf("cacheShards should be an integer: %w", err)
+	}
+	if cacheShards < 1 {
+		return nil, fmt.Errorf("cacheShards should be more than 1")
+	}
+	if cacheShards > 256 {
+		return nil, fmt.Errorf("cacheShards should be less than 256")
+	}
+
+	cacheMaxAgeStr := viper.GetString("cacheMaxAge")
+	cacheMaxAge, err := time.ParseDuration(cacheMaxAgeStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cacheMaxAge format: %w", err)
+	}
+	if cacheMaxAge < 0 {
+		return nil, fmt.Errorf("cacheMaxAge should not be negative")
+	}
+
+	cacheMaxSize := viper.GetSizeInBytes("cacheMaxSize")
+
+	expiryIntervalStr := viper.GetString("expiryInterval")
+	expiryInterval, err := time.ParseDuration(expiryIntervalStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid expiryInterval format: %w", err)
+	}
+	if expiryInterval < time.Second {
+		return nil, fmt.Errorf("expiryInterval should be more than 1s")
+	}
+
+	statsIntervalStr := viper.GetString("statsInterval")
+	statsInterval, err := time.ParseDuration(statsIntervalStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid statsInterval format: %w", err)
+	}
+	if statsInterval < time.Second {
+		return nil, fmt.Errorf("statsInterval should be more than 1s")
+	}
+	if statsInterval > time.Hour {
+		return nil, fmt.Errorf("statsInterval should be less than 1h")
+	}
+
+	storage := viper.GetString("storage")
+	if storage != "memory" && storage != "disk" {
+		return nil, fmt.Errorf("storage should be either memory or disk")
+	}
+
+	storageDir := viper.GetString("storageDir")
+	if storage == "disk" && storageDir == "" {
+		return nil, fmt.Errorf("storageDir is required for disk storage")
+	}
+
+	retentionStr := viper.GetString("storageRetention")
+	retention, err := time.ParseDuration(retentionStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid storageRetention format: %w", err)
+	}
+	if retention < 0 {
+		return nil, fmt.Errorf("storageRetention should not be negative")
+	}
+
+	storageMaxSize := viper.GetSizeInBytes("storageMaxSize")
+
 	brokerHost := viper.GetString("brokerHost")
 	if brokerHost == "" {
 		return nil, fmt.Errorf("brokerHost is required")
@@ -172,6 +516,58 @@ func loadConfig(configPath string) (*AppConfig, error) {
 		return nil, fmt.Errorf("topicName is required")
 	}
 
+	tripsTopic := viper.GetString("tripsTopicName")
+	if tripsTopic == "" {
+		return nil, fmt.Errorf("tripsTopicName is required")
+	}
+	if tripsTopic == topicName {
+		return nil, fmt.Errorf("tripsTopicName should differ from topicName")
+	}
+
+	commandsTopic := viper.GetString("commandsTopicName")
+	if commandsTopic == "" {
+		return nil, fmt.Errorf("commandsTopicName is required")
+	}
+	if commandsTopic == topicName || commandsTopic == tripsTopic {
+		return nil, fmt.Errorf("commandsTopicName should differ from topicName and tripsTopicName")
+	}
+
+	commandLatencyStr := viper.GetString("commandLatency")
+	commandLatency, err := time.ParseDuration(commandLatencyStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid commandLatency format: %w", err)
+	}
+	if commandLatency < 0 {
+		return nil, fmt.Errorf("commandLatency should not be negative")
+	}
+	if commandLatency > time.Hour {
+		return nil, fmt.Errorf("commandLatency should be less than 1h")
+	}
+
+	tripIdleSpeedStr := viper.GetString("tripIdleSpeed")
+	tripIdleSpeed, err := strconv.Atoi(tripIdleSpeedStr)
+	if err != nil {
+		return nil, fmt.Errorf("tripIdleSpeed should be an integer: %w", err)
+	}
+	if tripIdleSpeed < 0 {
+		return nil, fmt.Errorf("tripIdleSpeed should not be negative")
+	}
+	if tripIdleSpeed >= maxSpeed {
+		return nil, fmt.Errorf("tripIdleSpeed should be less than maxSpeed")
+	}
+
+	tripMinStopStr := viper.GetString("tripMinStop")
+	tripMinStop, err := time.ParseDuration(tripMinStopStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid tripMinStop format: %w", err)
+	}
+	if tripMinStop < 0 {
+		return nil, fmt.Errorf("tripMinStop should not be negative")
+	}
+	if tripMinStop > 24*time.Hour {
+		return nil, fmt.Errorf("tripMinStop should be less than 24h")
+	}
+
 	grpsPortStr := viper.GetString("grpsPort")
 	grpsPort, err := strconv.Atoi(grpsPortStr)
 	if err != nil {
@@ -184,17 +580,137 @@ func loadConfig(configPath string) (*AppConfig, error) {
 		return nil, fmt.Errorf("grpsPort should be less than 65536")
 	}
 
+	httpPortStr := viper.GetString("httpPort")
+	httpPort, err := strconv.Atoi(httpPortStr)
+	if err != nil {
+		return nil, fmt.Errorf("httpPort should be an integer: %w", err)
+	}
+	if httpPort < 1 || httpPort > 65535 {
+		return nil, fmt.Errorf("httpPort should be from 1 to 65535")
+	}
+	if httpPort == grpsPort {
+		return nil, fmt.Errorf("httpPort should differ from grpsPort")
+	}
+
+	healthIntervalStr := viper.GetString("healthInterval")
+	healthInterval, err := time.ParseDuration(healthIntervalStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid healthInterval format: %w", err)
+	}
+	if healthInterval < time.Second {
+		return nil, fmt.Errorf("healthInterval should be more than 1s")
+	}
+	if healthInterval > time.Minute {
+		return nil, fmt.Errorf("healthInterval should be less than 1m")
+	}
+
+	stateFile := viper.GetString("stateFile")
+
+	stateIntervalStr := viper.GetString("stateInterval")
+	stateInterval, err := time.ParseDuration(stateIntervalStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid stateInterval format: %w", err)
+	}
+	if stateInterval < time.Second {
+		return nil, fmt.Errorf("stateInterval should be more than 1s")
+	}
+	if stateInterval > time.Hour {
+		return nil, fmt.Errorf("stateInterval should be less than 1h")
+	}
+
+	freshStart := viper.GetBool("freshStart")
+
+	warmStart := viper.GetString("warmStart")
+	if warmStart != "none" && warmStart != "snapshot" && warmStart != "kafka" {
+		return nil, fmt.Errorf("warmStart should be none, snapshot or kafka")
+	}
+	if warmStart != "none" && storage != "memory" {
+		return nil, fmt.Errorf("warmStart should be none when storage is %s, only the memory cache is warmed up", storage)
+	}
+
+	snapshotFile := viper.GetString("snapshotFile")
+	if warmStart == "snapshot" && snapshotFile == "" {
+		return nil, fmt.Errorf("snapshotFile is required when warmStart is snapshot")
+	}
+
+	warmStartWindowStr := viper.GetString("warmStartWindow")
+	warmStartWindow, err := time.ParseDuration(warmStartWindowStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid warmStartWindow format: %w", err)
+	}
+	if warmStartWindow < time.Minute {
+		return nil, fmt.Errorf("warmStartWindow should be more than 1m")
+	}
+	if warmStartWindow > 24*time.Hour {
+		return nil, fmt.Errorf("warmStartWindow should be less than 24h")
+	}
+
 	return &AppConfig{
 		VehiclesCount: vehiclesCount,
+		Fleet:         profiles,
 		MaxSpeed:      maxSpeed,
 		MaxTimeStep:   int(maxTimeStep.Seconds()),
+		ClockTick:     clockTick,
+		ClockFactor:   clockFactor,
 		CacheSize:     cacheSize,
+		VehicleCache:  vehicleCacheSize,
+		CacheShards:   cacheShards,
+		CacheMaxAge:   cacheMaxAge,
+		CacheMaxSize:  int64(cacheMaxSize),
+		ExpiryEvery:   expiryInterval,
+		StatsEvery:    statsInterval,
 		BrokerHost:    brokerHost,
 		TopicName:     topicName,
+		TripsTopic:    tripsTopic,
+		CommandsTopic: commandsTopic,
+		Latency:       commandLatency,
+		Trips:         track.TripOptions{IdleSpeed: tripIdleSpeed, MinStop: tripMinStop},
+		Storage:       storage,
+		StorageDir:    storageDir,
+		Retention:     retention,
+		StorageMax:    int64(storageMaxSize),
 		GrpsPort:      grpsPort,
+		HttpPort:      httpPort,
+		HealthEvery:   healthInterval,
+		StateFile:     stateFile,
+		StateInterval: stateInterval,
+		FreshStart:    freshStart,
+		WarmStart:     warmStart,
+		SnapshotFile:  snapshotFile,
+		WarmWindow:    warmStartWindow,
 	}, nil
 }
 
+// warmStart fills the cache from the snapshot saved on the last shutdown or
+// from the recent messages of the topic, so history is available right away.
+func warmStart(c *cache.TelematicsDataCache, config *AppConfig) {
+	switch config.WarmStart {
+	case "snapshot":
+		records, err := cache.LoadSnapshot(config.SnapshotFile)
+		if err != nil {
+			log.Printf("Failed to load cache snapshot, starting with an empty cache: %v", err)
+			return
+		}
+		for _, record := range records {
+			c.Add(record)
+		}
+		log.Printf("Restored %d cached records from snapshot", len(records))
+	case "kafka":
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+
+		count := 0
+		err := kafka.ReadSince(ctx, config.BrokerHost, config.TopicName, time.Now().Add(-config.WarmWindow), func(data *protobuf.TelematicsDataProto) {
+			c.Add(convertFromProto(data))
+			count++
+		})
+		if err != nil {
+			log.Printf("Failed to read recent messages from Kafka: %v", err)
+		}
+		log.Printf("Restored %d cached records from Kafka", count)
+	}
+}
+
 func convertToProto(telematicsData models.TelematicsData) *protobuf.TelematicsDataProto {
 	return &protobuf.TelematicsDataProto{
 		VehicleId: int32(telematicsData.VehicleID),
@@ -204,3 +720,55 @@ func convertToProto(telematicsData models.TelematicsData) *protobuf.TelematicsDa
 		Longitude: telematicsData.Longitude,
 	}
 }
+
+func convertTripEventToProto(event track.TripEvent) *protobuf.TripEvent {
+	eventType := protobuf.TripEventType_TRIP_EVENT_STARTED
+	if event.Type == track.TripEnded {
+		eventType = protobuf.TripEventType_TRIP_EVENT_ENDED
+	}
+
+	return &protobuf.TripEvent{
+		Type: eventType,
+		Trip: &protobuf.Trip{
+			TripId:         event.Trip.ID(),
+			VehicleId:      int32(event.Trip.VehicleID),
+			Start:          convertToProto(event.Trip.Start),
+			End:            convertToProto(event.Trip.End),
+			DistanceMeters: event.Trip.Distance,
+			Duration:       durationpb.New(event.Trip.Duration()),
+			MaxSpeed:       int32(event.Trip.MaxSpeed),
+			Ongoing:        event.Trip.Ongoing,
+		},
+	}
+}
+
+func convertCommandAckToProto(ack control.Ack) *protobuf.CommandAck {
+	commandStatus := protobuf.CommandStatus_COMMAND_STATUS_APPLIED
+	if !ack.Applied {
+		commandStatus = protobuf.CommandStatus_COMMAND_STATUS_FAILED
+	}
+
+	message := &protobuf.CommandAck{
+		CommandId: ack.Command.ID,
+		VehicleId: int32(ack.Command.VehicleID),
+		Type:      protobuf.CommandType(ack.Command.Type),
+		Status:    commandStatus,
+		QueuedAt:  ack.Command.QueuedAt.UnixNano(),
+		AppliedAt: ack.AppliedAt.UnixNano(),
+		Error:     ack.Error,
+	}
+	if ack.Applied && ack.Command.Type == control.RequestPosition {
+		message.Position = convertToProto(ack.Position)
+	}
+	return message
+}
+
+func convertFromProto(data *protobuf.TelematicsDataProto) models.TelematicsData {
+	return models.TelematicsData{
+		VehicleID: int(data.VehicleId),
+		Timestamp: time.Unix(0, data.Timestamp),
+		Speed:     int(data.Speed),
+		Latitude:  data.Latitude,
+		Longitude: data.Longitude,
+	}
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"telematics-generator/pkg/fleet"
+	"telematics-generator/pkg/track"
+)
+
+// firstReleaseConfig is config.yaml as it was before any optional keys.
+const firstReleaseConfig = `vehiclesCount: 10
+maxSpeed: 120
+maxTimeStep: 60s
+cacheSize: 1000
+brokerHost: kafka:9092
+topicName: topic1
+grpsPort: 50051
+`
+
+func TestLoadConfigDefaults(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	if err := os.WriteFile(path, []byte(firstReleaseConfig), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	viper.Reset()
+	config, err := loadConfig(path)
+	if err != nil {
+		t.Fatalf("loadConfig() of the first release config error = %v", err)
+	}
+
+	if len(config.Fleet) != 1 || config.Fleet[0] != fleet.DefaultProfile {
+		t.Errorf("Fleet = %v, want the default profile only", config.Fleet)
+	}
+	if config.VehicleCache != config.CacheSize || config.CacheShards != 1 || config.CacheMaxAge != 0 || config.CacheMaxSize != 0 {
+		t.Errorf("cache config = %+v, want a single shard bounded by cacheSize only", config)
+	}
+	if config.Storage != "memory" || config.WarmStart != "none" || config.StateFile != "" {
+		t.Errorf("config = %+v, want memory storage without warm start and saved state", config)
+	}
+	if config.StatsEvery != time.Minute {
+		t.Errorf("StatsEvery = %v, want 1m", config.StatsEvery)
+	}
+	if config.ClockTick != time.Second || config.ClockFactor != 1 {
+		t.Errorf("clock config = %v, %v, want real time", config.ClockTick, config.ClockFactor)
+	}
+	if config.Trips != track.DefaultTripOptions {
+		t.Errorf("Trips = %+v, want %+v", config.Trips, track.DefaultTripOptions)
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	viper.Reset()
+	if _, err := loadConfig("../../config.yaml"); err != nil {
+		t.Fatalf("loadConfig() error = %v", err)
+	}
+}
+
+func TestLoadConfigWarmStartNeedsMemory(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	config := firstReleaseConfig + "storage: disk\nstorageDir: data\nwarmStart: snapshot\nsnapshotFile: snapshot.bin\n"
+	if err := os.WriteFile(path, []byte(config), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	viper.Reset()
+	if _, err := loadConfig(path); err == nil {
+		t.Errorf("loadConfig() of a warm start with disk storage error = nil")
+	}
+}
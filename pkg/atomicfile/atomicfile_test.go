@@ -0,0 +1,27 @@
+package atomicfile
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestWrite(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "data", "state.json")
+
+	for _, data := range []string{"first", "second"} {
+		if err := Write(path, []byte(data)); err != nil {
+			t.Fatalf("Write() error = %v", err)
+		}
+		got, err := os.ReadFile(path)
+		if err != nil || string(got) != data {
+			t.Errorf("ReadFile() = %q, %v, want %q", got, err, data)
+		}
+	}
+
+	entries, _ := os.ReadDir(filepath.Dir(path))
+	if len(entries) != 1 {
+		t.Errorf("got %v files, want no temporary files left", len(entries))
+	}
+}
@@ -1,11 +1,15 @@
 package cache
 
 import (
-	"container/list"
 	"errors"
 	"fmt"
+	"math"
+	"runtime"
+	"sort"
 	"sync"
+	"sync/atomic"
 	"time"
+	"unsafe"
 
 	"telematics-generator/pkg/models"
 )
@@ -13,84 +17,905 @@ import (
 type DataCacher interface {
 	GetLatest() (models.TelematicsData, bool)
 	GetRange(time.Time, time.Time) ([]models.TelematicsData, error)
+	GetRangeBounds(time.Time, time.Time, RangeBounds) ([]models.TelematicsData, error)
+	CheckRange(time.Time, time.Time) error
+	GetLatestForVehicle(int) (models.TelematicsData, bool)
+	GetLatestPerVehicle() []models.TelematicsData
+	GetRangeForVehicle(int, time.Time, time.Time, RangeBounds) ([]models.TelematicsData, error)
+	GetBracket(int, time.Time) (Bracket, error)
+	ListVehicles() []int
+	GetInArea(Area, time.Time, time.Time, RangeBounds) ([]models.TelematicsData, error)
+	GetRollups(time.Duration, time.Time, time.Time, ...int) ([]Rollup, error)
 	Add(models.TelematicsData)
 }
 
+var (
+	ErrUnknownVehicle = errors.New("unknown vehicle")
+	ErrOutOfRange     = errors.New("requested range is out of bounds")
+)
+
+// recordSize is the approximate memory taken by one cached record: its ring
+// buffer slot, its entries in the global, the vehicle and the spatial cell
+// time indexes and its sequence number in the vehicle partition.
+const recordSize = int64(unsafe.Sizeof(Item{}) + 3*unsafe.Sizeof(indexEntry{}) + unsafe.Sizeof(uint64(0)))
+
+// Expirer is implemented by caches that drop data by age.
+type Expirer interface {
+	Expire(time.Time)
+}
+
+// RunExpiry calls Expire with the time now returns every interval until stop
+// is closed.
+func RunExpiry(e Expirer, now func() time.Time, interval time.Duration, stop chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			e.Expire(now())
+		}
+	}
+}
+
+// Bracket holds the records of a vehicle closest to a point in time: the
+// latest one not after it and the earliest one not before it.
+type Bracket struct {
+	Before    models.TelematicsData
+	After     models.TelematicsData
+	HasBefore bool
+	HasAfter  bool
+}
+
+// RangeBounds tells whether the ends of a requested time range are included.
+type RangeBounds int
+
+const (
+	ExcludeBoth RangeBounds = 0
+	IncludeFrom RangeBounds = 1 << 0
+	IncludeTo   RangeBounds = 1 << 1
+	IncludeBoth             = IncludeFrom | IncludeTo
+)
+
+// TelematicsDataCache keeps the last capacity records in ring buffers and
+// evicts the oldest record when a new one does not fit. Every record is
+// identified by a Key, so records of different vehicles, or of one vehicle,
+// sharing a timestamp are all kept. Range queries are served by a time index,
+// which also keeps late, out-of-order records in their place.
+//
+// Vehicles are spread over shards, each with its own ring buffer, indexes and
+// read-write lock. The shards share the capacity: a ring buffer grows while
+// the cache has room, and once it is full the oldest record of the whole cache
+// is evicted, whatever shard it is in. Writers of different shards never wait
+// for each other unless one evicts from the shard of another, and a range read
+// holds a read lock of one shard at a time. The latest record of every vehicle
+// is published through an atomic pointer and is read without locking.
+//
+// Records are also split into per-vehicle partitions with their own time
+// index and capacity. A record evicted by its partition or expired by age
+// leaves a hole in the ring buffer, which is reused when the ring buffer wraps
+// around to it.
+//
+// For area queries records are indexed by geohash cells as well, with a time
+// index per cell. Minute and hour rollups of every vehicle are updated on
+// every added record and are kept after the records themselves are evicted.
 type TelematicsDataCache struct {
-	capacity     int
-	data         map[time.Time]*list.Element
-	list         *list.List
-	mx           sync.Mutex
-	minTimestamp time.Time
-	maxTimestamp time.Time
+	capacity        int
+	vehicleCapacity int
+	maxAge          time.Duration
+	maxBytes        int64
+	shardCount      int
+	now             func() time.Time
+	shards          []*shard
+	size            atomic.Int64
+	latest          sync.Map
+	vehicles        atomic.Int64
+	order           atomic.Uint64
+}
+
+// shard holds the records of a subset of vehicles. All fields but head are
+// guarded by mx. head is the insertion order of the oldest record in the ring
+// buffer, or math.MaxUint64 if it is empty, and is read without locking to
+// find the oldest record of the cache.
+type shard struct {
+	capacity        int
+	vehicleCapacity int
+	maxAge          time.Duration
+	items           []Item
+	index           timeIndex
+	partitions      map[int]*partition
+	spatial         spatialIndex
+	rollups         *rollups
+	next            uint64
+	size            int
+	live            int
+	evictions       evictions
+	mx              sync.RWMutex
+	minTimestamp    time.Time
+	maxTimestamp    time.Time
+	head            atomic.Uint64
+}
+
+// minRing is the initial length of the ring buffer of a shard.
+const minRing = 16
+
+type evictions struct {
+	capacity uint64
+	vehicle  uint64
+	age      uint64
+}
+
+type partition struct {
+	seqs  []uint64
+	index timeIndex
+}
+
+type Option func(*TelematicsDataCache)
+
+// WithVehicleCapacity limits the number of records kept for a single vehicle.
+func WithVehicleCapacity(vehicleCapacity int) Option {
+	return func(c *TelematicsDataCache) {
+		c.vehicleCapacity = vehicleCapacity
+	}
+}
+
+// WithMaxAge expires records with a timestamp older than maxAge.
+func WithMaxAge(maxAge time.Duration) Option {
+	return func(c *TelematicsDataCache) {
+		c.maxAge = maxAge
+	}
+}
+
+// WithMaxBytes limits the memory taken by the cached records. Records have
+// a fixed size, so the limit is turned into a smaller capacity if needed.
+// The latest records and the rollups of the vehicles are not counted.
+func WithMaxBytes(maxBytes int64) Option {
+	return func(c *TelematicsDataCache) {
+		c.maxBytes = maxBytes
+	}
+}
+
+// WithClock sets the clock the maximum age is measured by, e.g. the one of a
+// simulation. It is the wall clock by default.
+func WithClock(now func() time.Time) Option {
+	return func(c *TelematicsDataCache) {
+		c.now = now
+	}
+}
+
+// WithShards spreads vehicles over the given number of shards. The shards
+// share the capacity of the cache, so any number of shards keeps as many
+// records and evicts them in the order they were added.
+func WithShards(shards int) Option {
+	return func(c *TelematicsDataCache) {
+		c.shardCount = shards
+	}
+}
+
+// Key identifies a cached record. Seq is assigned by the shard of the vehicle
+// in insertion order and tells apart records with the same vehicle and
+// timestamp.
+type Key struct {
+	VehicleID int
+	Timestamp int64
+	Seq       uint64
 }
 
 type Item struct {
+	Key       Key
 	Timestamp time.Time
 	Data      models.TelematicsData
+	order     uint64
+	removed   bool
 }
 
-func NewTelematicsDataCache(capacity int) *TelematicsDataCache {
-	return &TelematicsDataCache{
-		capacity:     capacity,
-		data:         make(map[time.Time]*list.Element),
-		list:         list.New(),
-		minTimestamp: time.Now(),
-		maxTimestamp: time.Now(),
+// newer orders items newest first, records added later go first among the
+// ones with the same timestamp.
+func (i Item) newer(other Item) bool {
+	if i.Key.Timestamp != other.Key.Timestamp {
+		return i.Key.Timestamp > other.Key.Timestamp
 	}
+	return i.order > other.order
+}
+
+type Stats struct {
+	Len               int
+	Capacity          int
+	Vehicles          int
+	Bytes             int64
+	Evictions         uint64
+	CapacityEvictions uint64
+	VehicleEvictions  uint64
+	Expirations       uint64
+	MinTimestamp      time.Time
+	MaxTimestamp      time.Time
+}
+
+func NewTelematicsDataCache(capacity int, opts ...Option) *TelematicsDataCache {
+	c := &TelematicsDataCache{
+		capacity:        capacity,
+		vehicleCapacity: capacity,
+		shardCount:      1,
+		now:             time.Now,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	if c.maxBytes > 0 && c.maxBytes/recordSize < int64(c.capacity) {
+		c.capacity = int(c.maxBytes / recordSize)
+		if c.capacity < 1 {
+			c.capacity = 1
+		}
+	}
+	if c.shardCount < 1 {
+		c.shardCount = 1
+	}
+	if c.shardCount > c.capacity {
+		c.shardCount = c.capacity
+	}
+
+	ring := c.capacity
+	if c.shardCount > 1 && ring > minRing {
+		ring = minRing
+	}
+	c.shards = make([]*shard, c.shardCount)
+	for i := range c.shards {
+		c.shards[i] = &shard{
+			capacity:        c.capacity,
+			vehicleCapacity: c.vehicleCapacity,
+			maxAge:          c.maxAge,
+			items:           make([]Item, ring),
+			partitions:      make(map[int]*partition),
+			spatial:         newSpatialIndex(),
+			rollups:         newRollups(),
+			minTimestamp:    c.now(),
+			maxTimestamp:    c.now(),
+		}
+		c.shards[i].head.Store(math.MaxUint64)
+	}
+
+	return c
+}
+
+func (c *TelematicsDataCache) shardOf(vehicleID int) *shard {
+	return c.shards[uint(vehicleID)%uint(len(c.shards))]
+}
+
+// latestFor returns the latest record pointer of the vehicle, creating it
+// if needed. Only writers of the shard of the vehicle store to it.
+func (c *TelematicsDataCache) latestFor(vehicleID int) *atomic.Pointer[models.TelematicsData] {
+	if latest, ok := c.latest.Load(vehicleID); ok {
+		return latest.(*atomic.Pointer[models.TelematicsData])
+	}
+	latest, loaded := c.latest.LoadOrStore(vehicleID, &atomic.Pointer[models.TelematicsData]{})
+	if !loaded {
+		c.vehicles.Add(1)
+	}
+	return latest.(*atomic.Pointer[models.TelematicsData])
 }
 
 func (c *TelematicsDataCache) Add(telematicsData models.TelematicsData) {
-	c.mx.Lock()
-	defer c.mx.Unlock()
+	c.Put(telematicsData)
+}
+
+// Put adds the record like Add and returns the key it is stored under.
+func (c *TelematicsDataCache) Put(telematicsData models.TelematicsData) Key {
+	c.reserve()
+
+	s := c.shardOf(telematicsData.VehicleID)
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	if s.maxAge > 0 {
+		s.expire(c.now())
+	}
+	key := s.insert(telematicsData, c.order.Add(1))
+
+	latest := c.latestFor(key.VehicleID)
+	prev := latest.Load()
+	if prev != nil {
+		s.rollups.add(telematicsData, *prev, true)
+	} else {
+		s.rollups.add(telematicsData, models.TelematicsData{}, false)
+	}
+	if prev == nil || !telematicsData.Timestamp.Before(prev.Timestamp) {
+		latest.Store(&telematicsData)
+	}
+
+	return key
+}
+
+// reserve takes a ring buffer slot for a new record from the capacity shared
+// by the shards, evicting the oldest record of the cache if none is left.
+func (c *TelematicsDataCache) reserve() {
+	for {
+		size := c.size.Load()
+		if size < int64(c.capacity) {
+			if c.size.CompareAndSwap(size, size+1) {
+				return
+			}
+			continue
+		}
+		if !c.evictOldest() {
+			// Every slot is taken by records still being inserted.
+			runtime.Gosched()
+		}
+	}
+}
 
-	item := Item{
+// evictOldest evicts the record added first among the shards and tells
+// whether it found one.
+func (c *TelematicsDataCache) evictOldest() bool {
+	var oldest *shard
+	order := uint64(math.MaxUint64)
+	for _, s := range c.shards {
+		if head := s.head.Load(); head < order {
+			oldest, order = s, head
+		}
+	}
+	if oldest == nil {
+		return false
+	}
+
+	oldest.mx.Lock()
+	defer oldest.mx.Unlock()
+	// Another writer may have evicted it meanwhile.
+	if oldest.head.Load() == order {
+		oldest.evictOldest()
+		c.size.Add(-1)
+	}
+	return true
+}
+
+func (s *shard) insert(telematicsData models.TelematicsData, order uint64) Key {
+	if s.size == len(s.items) {
+		s.grow()
+	}
+
+	if p, ok := s.partitions[telematicsData.VehicleID]; ok && len(p.seqs) == s.vehicleCapacity {
+		s.remove(p.seqs[0])
+		s.evictions.vehicle++
+	}
+	p, ok := s.partitions[telematicsData.VehicleID]
+	if !ok {
+		p = &partition{}
+		s.partitions[telematicsData.VehicleID] = p
+	}
+
+	seq := s.next
+	key := Key{
+		VehicleID: telematicsData.VehicleID,
+		Timestamp: telematicsData.Timestamp.UnixNano(),
+		Seq:       seq,
+	}
+	s.items[s.slot(seq)] = Item{
+		Key:       key,
 		Timestamp: telematicsData.Timestamp,
 		Data:      telematicsData,
+		order:     order,
+	}
+	entry := indexEntry{timestamp: key.Timestamp, seq: seq}
+	s.index.insert(entry)
+	p.index.insert(entry)
+	s.spatial.insert(point(telematicsData), entry)
+	p.seqs = append(p.seqs, seq)
+	s.next++
+	s.size++
+	s.live++
+	if s.size == 1 {
+		s.head.Store(order)
 	}
-	element := c.list.PushFront(item)
-	c.data[item.Timestamp] = element
 
-	if telematicsData.Timestamp.Before(c.minTimestamp) {
-		c.minTimestamp = telematicsData.Timestamp
+	s.updateBounds()
+
+	return key
+}
+
+// grow doubles the ring buffer, up to the capacity of the cache.
+func (s *shard) grow() {
+	ring := 2 * len(s.items)
+	if ring > s.capacity {
+		ring = s.capacity
 	}
-	if telematicsData.Timestamp.After(c.maxTimestamp) {
-		c.maxTimestamp = telematicsData.Timestamp
+	items := make([]Item, ring)
+	for seq := s.next - uint64(s.size); seq < s.next; seq++ {
+		items[seq%uint64(ring)] = s.items[s.slot(seq)]
 	}
+	s.items = items
 }
 
+// GetLatest returns the last added record that is still cached.
 func (c *TelematicsDataCache) GetLatest() (models.TelematicsData, bool) {
-	c.mx.Lock()
-	defer c.mx.Unlock()
+	var latest Item
+	found := false
+	for _, s := range c.shards {
+		s.mx.RLock()
+		for i := 1; i <= s.size; i++ {
+			if item := s.items[s.slot(s.next-uint64(i))]; !item.removed {
+				if !found || item.order > latest.order {
+					latest = item
+					found = true
+				}
+				break
+			}
+		}
+		s.mx.RUnlock()
+	}
 
-	if c.list.Len() == 0 {
+	return latest.Data, found
+}
+
+// GetLatestForVehicle returns the record with the latest timestamp ever added
+// for the vehicle. It is kept even after the vehicle history is evicted.
+func (c *TelematicsDataCache) GetLatestForVehicle(vehicleID int) (models.TelematicsData, bool) {
+	latest, ok := c.latest.Load(vehicleID)
+	if !ok {
+		return models.TelematicsData{}, false
+	}
+	data := latest.(*atomic.Pointer[models.TelematicsData]).Load()
+	if data == nil {
 		return models.TelematicsData{}, false
 	}
+	return *data, true
+}
+
+// GetLatestPerVehicle returns the latest record of every vehicle ever added,
+// ordered by vehicle ID.
+func (c *TelematicsDataCache) GetLatestPerVehicle() []models.TelematicsData {
+	result := make([]models.TelematicsData, 0, c.vehicles.Load())
+	c.latest.Range(func(_, latest any) bool {
+		if data := latest.(*atomic.Pointer[models.TelematicsData]).Load(); data != nil {
+			result = append(result, *data)
+		}
+		return true
+	})
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].VehicleID < result[j].VehicleID
+	})
+
+	return result
+}
+
+// ListVehicles returns the IDs of all vehicles ever added, in ascending order.
+func (c *TelematicsDataCache) ListVehicles() []int {
+	vehicles := make([]int, 0, c.vehicles.Load())
+	c.latest.Range(func(vehicleID, latest any) bool {
+		if latest.(*atomic.Pointer[models.TelematicsData]).Load() != nil {
+			vehicles = append(vehicles, vehicleID.(int))
+		}
+		return true
+	})
+	sort.Ints(vehicles)
 
-	latest := c.list.Front()
-	return latest.Value.(Item).Data, true
+	return vehicles
 }
 
+// GetRange returns the records strictly between from and to, newest first.
 func (c *TelematicsDataCache) GetRange(from, to time.Time) ([]models.TelematicsData, error) {
-	c.mx.Lock()
-	defer c.mx.Unlock()
+	return c.GetRangeBounds(from, to, ExcludeBoth)
+}
 
+// GetRangeBounds returns the records between from and to, newest first,
+// including the ends of the range according to bounds.
+func (c *TelematicsDataCache) GetRangeBounds(from, to time.Time, bounds RangeBounds) ([]models.TelematicsData, error) {
 	if from.After(to) {
 		return nil, errors.New("the 'from' timestamp must be earlier than the 'to' timestamp")
 	}
-	if from.After(c.maxTimestamp) || to.Before(c.minTimestamp) {
-		return nil, fmt.Errorf("requested range is out of bounds. Available range is from %v (timestamp: %v) to %v (timestamp: %v)",
-			c.minTimestamp, c.minTimestamp.UnixNano(), c.maxTimestamp, c.maxTimestamp.UnixNano())
 
+	var r rangeBounds
+	lists := make([][]Item, 0, len(c.shards))
+	for _, s := range c.shards {
+		s.mx.RLock()
+		r.add(s)
+		lists = append(lists, s.collect(&s.index, from, to, bounds))
+		s.mx.RUnlock()
 	}
 
-	var result []models.TelematicsData
-	for e := c.list.Front(); e != nil; e = e.Next() {
-		item := e.Value.(Item)
-		if item.Timestamp.After(from) && item.Timestamp.Before(to) {
-			result = append(result, item.Data)
+	if err := r.check(from, to); err != nil {
+		return nil, err
+	}
+
+	return merge(lists), nil
+}
+
+// CheckRange returns the error GetRange returns when the range is out of the
+// retained records, or nil.
+func (c *TelematicsDataCache) CheckRange(from, to time.Time) error {
+	var r rangeBounds
+	for _, s := range c.shards {
+		s.mx.RLock()
+		r.add(s)
+		s.mx.RUnlock()
+	}
+
+	return r.check(from, to)
+}
+
+// GetInArea returns the records inside the area between from and to, newest
+// first, including the ends of the range according to bounds.
+func (c *TelematicsDataCache) GetInArea(area Area, from, to time.Time, bounds RangeBounds) ([]models.TelematicsData, error) {
+	if from.After(to) {
+		return nil, errors.New("the 'from' timestamp must be earlier than the 'to' timestamp")
+	}
+
+	var r rangeBounds
+	lists := make([][]Item, 0, len(c.shards))
+	for _, s := range c.shards {
+		s.mx.RLock()
+		r.add(s)
+		lists = append(lists, s.collectInArea(area, from, to, bounds))
+		s.mx.RUnlock()
+	}
+
+	if err := r.check(from, to); err != nil {
+		return nil, err
+	}
+
+	return merge(lists), nil
+}
+
+func (s *shard) collectInArea(area Area, from, to time.Time, bounds RangeBounds) []Item {
+	var entries []indexEntry
+	for _, index := range s.spatial.covering(area.Bounds()) {
+		start := index.lowerBound(from.UnixNano(), bounds&IncludeFrom != 0)
+		end := index.lowerBound(to.UnixNano(), bounds&IncludeTo == 0)
+		index.ascend(start, end, func(e indexEntry) bool {
+			if area.Contains(point(s.items[s.slot(e.seq)].Data)) {
+				entries = append(entries, e)
+			}
+			return true
+		})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[j].less(entries[i])
+	})
+
+	result := make([]Item, 0, len(entries))
+	for _, e := range entries {
+		result = append(result, s.items[s.slot(e.seq)])
+	}
+
+	return result
+}
+
+// GetRollups returns the rollups of the given resolution overlapping the range
+// for the given vehicles, or for all vehicles if none are given, newest first.
+func (c *TelematicsDataCache) GetRollups(resolution time.Duration, from, to time.Time, vehicleIDs ...int) ([]Rollup, error) {
+	byShard := make(map[*shard][]int)
+	for _, vehicleID := range vehicleIDs {
+		s := c.shardOf(vehicleID)
+		byShard[s] = append(byShard[s], vehicleID)
+	}
+
+	var result []Rollup
+	for _, s := range c.shards {
+		ids, ok := byShard[s]
+		if len(vehicleIDs) > 0 && !ok {
+			continue
 		}
+
+		s.mx.RLock()
+		rollups, err := s.rollups.get(resolution, from, to, ids)
+		s.mx.RUnlock()
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, rollups...)
+	}
+	if len(c.shards) > 1 {
+		sortRollups(result)
 	}
 
 	return result, nil
 }
+
+// rangeBounds accumulates the retained time range of the shards.
+type rangeBounds struct {
+	live         int
+	minTimestamp time.Time
+	maxTimestamp time.Time
+}
+
+func (r *rangeBounds) add(s *shard) {
+	if s.live == 0 {
+		return
+	}
+	if r.live == 0 || s.minTimestamp.Before(r.minTimestamp) {
+		r.minTimestamp = s.minTimestamp
+	}
+	if r.live == 0 || s.maxTimestamp.After(r.maxTimestamp) {
+		r.maxTimestamp = s.maxTimestamp
+	}
+	r.live += s.live
+}
+
+func (r *rangeBounds) check(from, to time.Time) error {
+	if r.live == 0 {
+		return fmt.Errorf("%w. No data is retained", ErrOutOfRange)
+	}
+	if from.After(r.maxTimestamp) || to.Before(r.minTimestamp) {
+		return fmt.Errorf("%w. Available range is from %v (timestamp: %v) to %v (timestamp: %v)",
+			ErrOutOfRange, r.minTimestamp, r.minTimestamp.UnixNano(), r.maxTimestamp, r.maxTimestamp.UnixNano())
+	}
+	return nil
+}
+
+// GetRangeForVehicle returns the records of one vehicle between from and to,
+// newest first, including the ends of the range according to bounds.
+func (c *TelematicsDataCache) GetRangeForVehicle(vehicleID int, from, to time.Time, bounds RangeBounds) ([]models.TelematicsData, error) {
+	if from.After(to) {
+		return nil, errors.New("the 'from' timestamp must be earlier than the 'to' timestamp")
+	}
+
+	s := c.shardOf(vehicleID)
+	s.mx.RLock()
+	defer s.mx.RUnlock()
+
+	p, ok := s.partitions[vehicleID]
+	if !ok {
+		return nil, fmt.Errorf("%w %d: no data available", ErrUnknownVehicle, vehicleID)
+	}
+
+	first, _ := p.index.first()
+	last, _ := p.index.last()
+	minTimestamp := s.items[s.slot(first.seq)].Timestamp
+	maxTimestamp := s.items[s.slot(last.seq)].Timestamp
+	if from.After(maxTimestamp) || to.Before(minTimestamp) {
+		return nil, fmt.Errorf("%w. Available range for vehicle %d is from %v (timestamp: %v) to %v (timestamp: %v)",
+			ErrOutOfRange, vehicleID, minTimestamp, minTimestamp.UnixNano(), maxTimestamp, maxTimestamp.UnixNano())
+	}
+
+	return merge([][]Item{s.collect(&p.index, from, to, bounds)}), nil
+}
+
+// GetBracket returns the cached records of the vehicle around t.
+func (c *TelematicsDataCache) GetBracket(vehicleID int, t time.Time) (Bracket, error) {
+	s := c.shardOf(vehicleID)
+	s.mx.RLock()
+	defer s.mx.RUnlock()
+
+	p, ok := s.partitions[vehicleID]
+	if !ok {
+		return Bracket{}, fmt.Errorf("%w %d: no data available", ErrUnknownVehicle, vehicleID)
+	}
+
+	var b Bracket
+	ts := t.UnixNano()
+	if before := p.index.prev(p.index.lowerBound(ts, false)); before.seg >= 0 {
+		b.Before = s.items[s.slot(p.index.segments[before.seg][before.off].seq)].Data
+		b.HasBefore = true
+	}
+	if after := p.index.lowerBound(ts, true); after.seg < len(p.index.segments) {
+		b.After = s.items[s.slot(p.index.segments[after.seg][after.off].seq)].Data
+		b.HasAfter = true
+	}
+
+	return b, nil
+}
+
+func (s *shard) collect(index *timeIndex, from, to time.Time, bounds RangeBounds) []Item {
+	start := index.lowerBound(from.UnixNano(), bounds&IncludeFrom != 0)
+	end := index.lowerBound(to.UnixNano(), bounds&IncludeTo == 0)
+
+	var result []Item
+	index.descend(start, end, func(e indexEntry) bool {
+		result = append(result, s.items[s.slot(e.seq)])
+		return true
+	})
+
+	return result
+}
+
+// merge merges lists of items sorted newest first into a single list of
+// records sorted the same way.
+func merge(lists [][]Item) []models.TelematicsData {
+	for len(lists) > 1 {
+		merged := lists[:0]
+		for i := 0; i < len(lists); i += 2 {
+			if i+1 == len(lists) {
+				merged = append(merged, lists[i])
+				break
+			}
+			merged = append(merged, mergeTwo(lists[i], lists[i+1]))
+		}
+		lists = merged
+	}
+
+	var result []models.TelematicsData
+	if len(lists) == 0 || len(lists[0]) == 0 {
+		return result
+	}
+	result = make([]models.TelematicsData, 0, len(lists[0]))
+	for _, item := range lists[0] {
+		result = append(result, item.Data)
+	}
+	return result
+}
+
+func mergeTwo(a, b []Item) []Item {
+	if len(a) == 0 {
+		return b
+	}
+	if len(b) == 0 {
+		return a
+	}
+
+	result := make([]Item, 0, len(a)+len(b))
+	for len(a) > 0 && len(b) > 0 {
+		if a[0].newer(b[0]) {
+			result = append(result, a[0])
+			a = a[1:]
+		} else {
+			result = append(result, b[0])
+			b = b[1:]
+		}
+	}
+	result = append(result, a...)
+	return append(result, b...)
+}
+
+// Get returns the record stored under the key, if it has not been evicted yet.
+func (c *TelematicsDataCache) Get(key Key) (models.TelematicsData, bool) {
+	s := c.shardOf(key.VehicleID)
+	s.mx.RLock()
+	defer s.mx.RUnlock()
+
+	if !s.contains(key.Seq) {
+		return models.TelematicsData{}, false
+	}
+
+	item := s.items[s.slot(key.Seq)]
+	if item.removed || item.Key != key {
+		return models.TelematicsData{}, false
+	}
+
+	return item.Data, true
+}
+
+// Lookup returns all records of the vehicle with exactly the given timestamp
+// in the order they were added.
+func (c *TelematicsDataCache) Lookup(vehicleID int, timestamp time.Time) []models.TelematicsData {
+	s := c.shardOf(vehicleID)
+	s.mx.RLock()
+	defer s.mx.RUnlock()
+
+	p, ok := s.partitions[vehicleID]
+	if !ok {
+		return nil
+	}
+
+	ts := timestamp.UnixNano()
+
+	var result []models.TelematicsData
+	p.index.ascend(p.index.lowerBound(ts, true), p.index.lowerBound(ts, false), func(e indexEntry) bool {
+		result = append(result, s.items[s.slot(e.seq)].Data)
+		return true
+	})
+
+	return result
+}
+
+func (c *TelematicsDataCache) Stats() Stats {
+	stats := Stats{
+		Capacity: c.capacity,
+		Vehicles: int(c.vehicles.Load()),
+	}
+
+	var r rangeBounds
+	for _, s := range c.shards {
+		s.mx.RLock()
+		r.add(s)
+		stats.CapacityEvictions += s.evictions.capacity
+		stats.VehicleEvictions += s.evictions.vehicle
+		stats.Expirations += s.evictions.age
+		s.mx.RUnlock()
+	}
+
+	stats.Len = r.live
+	stats.Bytes = int64(r.live) * recordSize
+	stats.Evictions = stats.CapacityEvictions + stats.VehicleEvictions + stats.Expirations
+	if r.live > 0 {
+		stats.MinTimestamp = r.minTimestamp
+		stats.MaxTimestamp = r.maxTimestamp
+	}
+
+	return stats
+}
+
+// Expire drops the records older than the maximum age. Put does it in the
+// shard it adds to, Expire lets the cache shrink when nothing is added.
+func (c *TelematicsDataCache) Expire(now time.Time) {
+	for _, s := range c.shards {
+		s.mx.Lock()
+		s.expire(now)
+		s.mx.Unlock()
+	}
+}
+
+func (s *shard) expire(now time.Time) {
+	if s.maxAge <= 0 {
+		return
+	}
+
+	cutoff := now.Add(-s.maxAge).UnixNano()
+	expired := false
+	for {
+		first, ok := s.index.first()
+		if !ok || first.timestamp >= cutoff {
+			break
+		}
+		s.remove(first.seq)
+		s.evictions.age++
+		expired = true
+	}
+
+	if expired {
+		s.updateBounds()
+	}
+}
+
+func (s *shard) evictOldest() {
+	oldest := s.next - uint64(s.size)
+	if !s.items[s.slot(oldest)].removed {
+		s.remove(oldest)
+		s.evictions.capacity++
+	}
+
+	s.items[s.slot(oldest)] = Item{}
+	s.size--
+	if s.size == 0 {
+		s.head.Store(math.MaxUint64)
+	} else {
+		s.head.Store(s.items[s.slot(oldest+1)].order)
+	}
+}
+
+// remove drops a live record from the indexes and its partition, leaving
+// a hole in the ring buffer.
+func (s *shard) remove(seq uint64) {
+	item := &s.items[s.slot(seq)]
+	item.removed = true
+	s.live--
+
+	entry := indexEntry{timestamp: item.Key.Timestamp, seq: seq}
+	s.index.remove(entry)
+
+	s.spatial.remove(point(item.Data), entry)
+
+	p := s.partitions[item.Key.VehicleID]
+	p.index.remove(entry)
+	if i := sort.Search(len(p.seqs), func(i int) bool { return p.seqs[i] >= seq }); i == 0 {
+		p.seqs = p.seqs[1:]
+	} else {
+		p.seqs = append(p.seqs[:i], p.seqs[i+1:]...)
+	}
+	if len(p.seqs) == 0 {
+		delete(s.partitions, item.Key.VehicleID)
+	}
+}
+
+func (s *shard) updateBounds() {
+	first, ok := s.index.first()
+	if !ok {
+		return
+	}
+	last, _ := s.index.last()
+
+	s.minTimestamp = s.items[s.slot(first.seq)].Timestamp
+	s.maxTimestamp = s.items[s.slot(last.seq)].Timestamp
+}
+
+func point(telematicsData models.TelematicsData) Point {
+	return Point{Latitude: telematicsData.Latitude, Longitude: telematicsData.Longitude}
+}
+
+func (s *shard) contains(seq uint64) bool {
+	return seq < s.next && seq >= s.next-uint64(s.size)
+}
+
+func (s *shard) slot(seq uint64) int {
+	return int(seq % uint64(len(s.items)))
+}
@@ -1,6 +1,12 @@
 package cache
 
 import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"telematics-generator/pkg/clock"
 	"telematics-generator/pkg/models"
 	"testing"
 	"time"
@@ -82,3 +88,545 @@ func TestGetRange(t *testing.T) {
 		t.Errorf("GetRange() = %v, want %v", result, []models.TelematicsData{data1})
 	}
 }
+
+func TestEvictionWraparound(t *testing.T) {
+	c := NewTelematicsDataCache(3)
+	start := time.Now()
+
+	var added []models.TelematicsData
+	for i := 0; i < 7; i++ {
+		data := models.TelematicsData{
+			VehicleID: i,
+			Timestamp: start.Add(time.Duration(i) * time.Second),
+			Speed:     i,
+		}
+		added = append(added, data)
+		c.Add(data)
+	}
+
+	stats := c.Stats()
+	if stats.Len != 3 || stats.Capacity != 3 || stats.Evictions != 4 {
+		t.Errorf("Stats() = %+v, want Len 3, Capacity 3, Evictions 4", stats)
+	}
+
+	result, err := c.GetRange(start.Add(-time.Minute), start.Add(time.Minute))
+	if err != nil {
+		t.Fatalf("GetRange() error = %v", err)
+	}
+	want := []models.TelematicsData{added[6], added[5], added[4]}
+	if len(result) != len(want) {
+		t.Fatalf("GetRange() = %v, want %v", result, want)
+	}
+	for i := range want {
+		if result[i] != want[i] {
+			t.Errorf("GetRange()[%d] = %v, want %v", i, result[i], want[i])
+		}
+	}
+
+	if !stats.MinTimestamp.Equal(added[4].Timestamp) || !stats.MaxTimestamp.Equal(added[6].Timestamp) {
+		t.Errorf("bounds = [%v, %v], want [%v, %v]",
+			stats.MinTimestamp, stats.MaxTimestamp, added[4].Timestamp, added[6].Timestamp)
+	}
+
+	_, err = c.GetRange(start.Add(-time.Minute), start.Add(3*time.Second))
+	if err == nil {
+		t.Errorf("GetRange() of an evicted range should return an error")
+	}
+}
+
+func TestBoundsAfterOutOfOrderEviction(t *testing.T) {
+	c := NewTelematicsDataCache(3)
+	start := time.Now()
+
+	offsets := []int{5, 1, 9, 3, 7, 2}
+	for i, offset := range offsets {
+		c.Add(models.TelematicsData{Timestamp: start.Add(time.Duration(offset) * time.Second)})
+
+		window := offsets[:i+1]
+		if len(window) > 3 {
+			window = window[len(window)-3:]
+		}
+		minOffset, maxOffset := window[0], window[0]
+		for _, o := range window {
+			if o < minOffset {
+				minOffset = o
+			}
+			if o > maxOffset {
+				maxOffset = o
+			}
+		}
+
+		wantMin := start.Add(time.Duration(minOffset) * time.Second)
+		wantMax := start.Add(time.Duration(maxOffset) * time.Second)
+		stats := c.Stats()
+		if !stats.MinTimestamp.Equal(wantMin) || !stats.MaxTimestamp.Equal(wantMax) {
+			t.Errorf("after adding %v bounds = [%v, %v], want [%v, %v]",
+				offset, stats.MinTimestamp, stats.MaxTimestamp, wantMin, wantMax)
+		}
+	}
+}
+
+func TestConcurrentAddAndGetRange(t *testing.T) {
+	c := NewTelematicsDataCache(100)
+	start := time.Now()
+
+	var wg sync.WaitGroup
+	for w := 0; w < 4; w++ {
+		wg.Add(1)
+		go func(vehicleID int) {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				c.Add(models.TelematicsData{
+					VehicleID: vehicleID,
+					Timestamp: start.Add(time.Duration(i) * time.Millisecond),
+				})
+			}
+		}(w)
+	}
+	for r := 0; r < 4; r++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 200; i++ {
+				result, err := c.GetRange(start.Add(-time.Second), start.Add(time.Hour))
+				if err == nil && len(result) > 100 {
+					t.Errorf("GetRange() returned %v records, capacity is 100", len(result))
+				}
+				c.GetLatest()
+			}
+		}()
+	}
+	wg.Wait()
+
+	stats := c.Stats()
+	if stats.Len != 100 || stats.Evictions != 3900 {
+		t.Errorf("Stats() = %+v, want Len 100, Evictions 3900", stats)
+	}
+}
+
+func TestSameTimestampIsNotLost(t *testing.T) {
+	c := NewTelematicsDataCache(10)
+	now := time.Now()
+
+	first := models.TelematicsData{VehicleID: 1, Timestamp: now, Speed: 10}
+	second := models.TelematicsData{VehicleID: 2, Timestamp: now, Speed: 20}
+	duplicate := models.TelematicsData{VehicleID: 1, Timestamp: now, Speed: 30}
+
+	firstKey := c.Put(first)
+	secondKey := c.Put(second)
+	duplicateKey := c.Put(duplicate)
+
+	if firstKey == duplicateKey {
+		t.Fatalf("records with the same vehicle and timestamp got the same key %v", firstKey)
+	}
+	for key, want := range map[Key]models.TelematicsData{firstKey: first, secondKey: second, duplicateKey: duplicate} {
+		got, ok := c.Get(key)
+		if !ok || got != want {
+			t.Errorf("Get(%v) = %v, %v, want %v", key, got, ok, want)
+		}
+	}
+
+	got := c.Lookup(1, now)
+	if len(got) != 2 || got[0] != first || got[1] != duplicate {
+		t.Errorf("Lookup() = %v, want %v", got, []models.TelematicsData{first, duplicate})
+	}
+
+	result, err := c.GetRange(now.Add(-time.Second), now.Add(time.Second))
+	if err != nil {
+		t.Fatalf("GetRange() error = %v", err)
+	}
+	if len(result) != 3 {
+		t.Errorf("GetRange() = %v, want 3 records", result)
+	}
+}
+
+func TestKeysAfterEviction(t *testing.T) {
+	c := NewTelematicsDataCache(2)
+	now := time.Now()
+
+	evicted := c.Put(models.TelematicsData{VehicleID: 1, Timestamp: now})
+	kept := c.Put(models.TelematicsData{VehicleID: 1, Timestamp: now, Speed: 1})
+	c.Put(models.TelematicsData{VehicleID: 2, Timestamp: now})
+
+	if _, ok := c.Get(evicted); ok {
+		t.Errorf("Get() of an evicted key should fail")
+	}
+	if _, ok := c.Get(kept); !ok {
+		t.Errorf("Get() of a retained key should succeed")
+	}
+	if got := c.Lookup(1, now); len(got) != 1 || got[0].Speed != 1 {
+		t.Errorf("Lookup() after eviction = %v, want the retained record only", got)
+	}
+	if c.shards[0].index.len() != 2 {
+		t.Errorf("index has %v entries, want 2", c.shards[0].index.len())
+	}
+}
+
+func TestVehiclePartitions(t *testing.T) {
+	c := NewTelematicsDataCache(10, WithVehicleCapacity(2))
+	now := time.Now()
+
+	for i := 0; i < 3; i++ {
+		c.Add(models.TelematicsData{VehicleID: 1, Timestamp: now.Add(time.Duration(i) * time.Second), Speed: i})
+	}
+	c.Add(models.TelematicsData{VehicleID: 2, Timestamp: now, Speed: 100})
+
+	if got := c.ListVehicles(); len(got) != 2 || got[0] != 1 || got[1] != 2 {
+		t.Errorf("ListVehicles() = %v, want [1 2]", got)
+	}
+
+	result, err := c.GetRangeForVehicle(1, now.Add(-time.Minute), now.Add(time.Minute), IncludeBoth)
+	if err != nil {
+		t.Fatalf("GetRangeForVehicle() error = %v", err)
+	}
+	if len(result) != 2 || result[0].Speed != 2 || result[1].Speed != 1 {
+		t.Errorf("GetRangeForVehicle() = %v, want the two newest records of vehicle 1", result)
+	}
+
+	if _, err := c.GetRangeForVehicle(3, now, now, IncludeBoth); !errors.Is(err, ErrUnknownVehicle) {
+		t.Errorf("GetRangeForVehicle() of an unknown vehicle error = %v, want ErrUnknownVehicle", err)
+	}
+	if _, err := c.GetRangeForVehicle(1, now.Add(-time.Minute), now.Add(-time.Second), IncludeBoth); err == nil {
+		t.Errorf("GetRangeForVehicle() of an evicted range should return an error")
+	}
+
+	stats := c.Stats()
+	if stats.Len != 3 || stats.Vehicles != 2 || stats.Evictions != 1 {
+		t.Errorf("Stats() = %+v, want Len 3, Vehicles 2, Evictions 1", stats)
+	}
+
+	all, err := c.GetRange(now.Add(-time.Minute), now.Add(time.Minute))
+	if err != nil {
+		t.Fatalf("GetRange() error = %v", err)
+	}
+	if len(all) != 3 {
+		t.Errorf("GetRange() = %v, want 3 records", all)
+	}
+}
+
+func TestLatestForVehicleSurvivesEviction(t *testing.T) {
+	c := NewTelematicsDataCache(2)
+	now := time.Now()
+
+	first := models.TelematicsData{VehicleID: 1, Timestamp: now, Speed: 10}
+	c.Add(first)
+	c.Add(models.TelematicsData{VehicleID: 1, Timestamp: now.Add(-time.Second), Speed: 20})
+	for i := 0; i < 2; i++ {
+		c.Add(models.TelematicsData{VehicleID: 2, Timestamp: now.Add(time.Duration(i) * time.Second)})
+	}
+
+	latest, ok := c.GetLatestForVehicle(1)
+	if !ok || latest != first {
+		t.Errorf("GetLatestForVehicle() = %v, %v, want %v", latest, ok, first)
+	}
+	if _, ok := c.GetLatestForVehicle(3); ok {
+		t.Errorf("GetLatestForVehicle() of an unknown vehicle should fail")
+	}
+	if all := c.GetLatestPerVehicle(); len(all) != 2 || all[0] != first || all[1].VehicleID != 2 {
+		t.Errorf("GetLatestPerVehicle() = %v, want the latest records of vehicles 1 and 2", all)
+	}
+	if _, err := c.GetRangeForVehicle(1, now.Add(-time.Minute), now, IncludeBoth); !errors.Is(err, ErrUnknownVehicle) {
+		t.Errorf("GetRangeForVehicle() of an evicted vehicle error = %v, want ErrUnknownVehicle", err)
+	}
+}
+
+func TestPartitionEvictionHolesAreReused(t *testing.T) {
+	c := NewTelematicsDataCache(3, WithVehicleCapacity(1))
+	now := time.Now()
+
+	for i := 0; i < 10; i++ {
+		c.Add(models.TelematicsData{VehicleID: i % 2, Timestamp: now.Add(time.Duration(i) * time.Second), Speed: i})
+
+		stats := c.Stats()
+		wantLen := i + 1
+		if wantLen > 2 {
+			wantLen = 2
+		}
+		if stats.Len != wantLen {
+			t.Fatalf("after %d records Stats().Len = %v, want %v", i+1, stats.Len, wantLen)
+		}
+	}
+
+	latest, ok := c.GetLatest()
+	if !ok || latest.Speed != 9 {
+		t.Errorf("GetLatest() = %v, want the last added record", latest)
+	}
+	result, err := c.GetRange(now.Add(-time.Minute), now.Add(time.Minute))
+	if err != nil {
+		t.Fatalf("GetRange() error = %v", err)
+	}
+	if len(result) != 2 || result[0].Speed != 9 || result[1].Speed != 8 {
+		t.Errorf("GetRange() = %v, want the last record of each vehicle", result)
+	}
+}
+
+func TestMaxAge(t *testing.T) {
+	c := NewTelematicsDataCache(10, WithMaxAge(time.Hour))
+	now := time.Now()
+
+	c.Add(models.TelematicsData{VehicleID: 1, Timestamp: now.Add(-50 * time.Minute)})
+	c.Add(models.TelematicsData{VehicleID: 2, Timestamp: now.Add(-40 * time.Minute)})
+	c.Add(models.TelematicsData{VehicleID: 1, Timestamp: now.Add(-2 * time.Minute)})
+
+	c.Expire(now.Add(25 * time.Minute))
+
+	stats := c.Stats()
+	if stats.Len != 1 || stats.Expirations != 2 || stats.Evictions != 2 {
+		t.Errorf("Stats() = %+v, want Len 1, Expirations 2, Evictions 2", stats)
+	}
+	if !stats.MinTimestamp.Equal(now.Add(-2*time.Minute)) || !stats.MaxTimestamp.Equal(now.Add(-2*time.Minute)) {
+		t.Errorf("Stats() window = [%v, %v], want the retained record only", stats.MinTimestamp, stats.MaxTimestamp)
+	}
+
+	_, err := c.GetRange(now.Add(-time.Hour), now.Add(-30*time.Minute))
+	if err == nil || !strings.Contains(err.Error(), fmt.Sprint(now.Add(-2*time.Minute).UnixNano())) {
+		t.Errorf("GetRange() of an expired range error = %v, want the retained window in it", err)
+	}
+	if _, err := c.GetRangeForVehicle(2, now.Add(-time.Hour), now, IncludeBoth); !errors.Is(err, ErrUnknownVehicle) {
+		t.Errorf("GetRangeForVehicle() of an expired vehicle error = %v, want ErrUnknownVehicle", err)
+	}
+
+	c.Expire(now.Add(time.Hour))
+	if _, err := c.GetRange(now.Add(-time.Hour), now); err == nil {
+		t.Errorf("GetRange() of an empty cache should return an error")
+	}
+	if _, ok := c.GetLatest(); ok {
+		t.Errorf("GetLatest() of an empty cache should fail")
+	}
+}
+
+func TestMaxAgeFollowsClock(t *testing.T) {
+	simClock, _ := clock.New(time.Second, 1)
+	simClock.Jump(24 * time.Hour)
+	start := simClock.Pause().Now
+	c := NewTelematicsDataCache(10, WithMaxAge(time.Hour), WithClock(simClock.Now))
+
+	// The records are a day ahead of the wall clock, so only the simulation
+	// clock can make them old.
+	c.Add(models.TelematicsData{VehicleID: 1, Timestamp: start.Add(-30 * time.Minute)})
+	c.Add(models.TelematicsData{VehicleID: 1, Timestamp: start})
+	time.Sleep(10 * time.Millisecond)
+	c.Add(models.TelematicsData{VehicleID: 2, Timestamp: start})
+	if stats := c.Stats(); stats.Len != 3 {
+		t.Fatalf("Stats() = %+v, want all records kept while the clock is paused", stats)
+	}
+
+	simClock.Jump(45 * time.Minute)
+	c.Add(models.TelematicsData{VehicleID: 2, Timestamp: start.Add(45 * time.Minute)})
+	if stats := c.Stats(); stats.Len != 3 || stats.Expirations != 1 {
+		t.Errorf("Stats() = %+v, want the record older than 1h by the clock expired", stats)
+	}
+
+	simClock.Jump(2 * time.Hour)
+	stop := make(chan struct{})
+	defer close(stop)
+	go RunExpiry(c, simClock.Now, time.Millisecond, stop)
+	for deadline := time.Now().Add(time.Second); c.Stats().Len > 0; time.Sleep(time.Millisecond) {
+		if time.Now().After(deadline) {
+			t.Fatalf("Stats() = %+v, want RunExpiry to expire every record by the clock", c.Stats())
+		}
+	}
+}
+
+func TestMaxBytes(t *testing.T) {
+	c := NewTelematicsDataCache(1000, WithMaxBytes(10*recordSize))
+	now := time.Now()
+
+	for i := 0; i < 25; i++ {
+		c.Add(models.TelematicsData{VehicleID: i % 3, Timestamp: now.Add(time.Duration(i) * time.Second)})
+	}
+
+	stats := c.Stats()
+	if stats.Capacity != 10 || stats.Len != 10 || stats.Bytes > 10*recordSize {
+		t.Errorf("Stats() = %+v, want Capacity 10, Len 10 and at most %v bytes", stats, 10*recordSize)
+	}
+	if stats.CapacityEvictions != 15 {
+		t.Errorf("Stats().CapacityEvictions = %v, want 15", stats.CapacityEvictions)
+	}
+}
+
+func TestShards(t *testing.T) {
+	c := NewTelematicsDataCache(10, WithShards(4))
+	start := time.Now()
+
+	if len(c.shards) != 4 {
+		t.Fatalf("got %d shards, want 4", len(c.shards))
+	}
+
+	for i := 0; i < 8; i++ {
+		c.Add(models.TelematicsData{VehicleID: i, Timestamp: start.Add(time.Duration(i%4) * time.Second), Speed: i})
+	}
+
+	result, err := c.GetRange(start.Add(-time.Minute), start.Add(time.Minute))
+	if err != nil {
+		t.Fatalf("GetRange() error = %v", err)
+	}
+	want := []int{7, 3, 6, 2, 5, 1, 4, 0}
+	if len(result) != len(want) {
+		t.Fatalf("GetRange() = %v, want 8 records", result)
+	}
+	for i, speed := range want {
+		if result[i].Speed != speed {
+			t.Errorf("GetRange()[%d] = %v, want the record with speed %v", i, result[i], speed)
+		}
+	}
+
+	if latest, ok := c.GetLatest(); !ok || latest.Speed != 7 {
+		t.Errorf("GetLatest() = %v, want the last added record", latest)
+	}
+	if vehicles := c.ListVehicles(); len(vehicles) != 8 || vehicles[7] != 7 {
+		t.Errorf("ListVehicles() = %v, want 0 to 7", vehicles)
+	}
+	if rollups, err := c.GetRollups(MinuteResolution, start.Add(-time.Minute), start.Add(time.Minute), 2, 5); err != nil || len(rollups) != 2 {
+		t.Errorf("GetRollups() = %v, %v, want rollups of 2 vehicles", rollups, err)
+	}
+
+	for i := 8; i < 12; i++ {
+		c.Add(models.TelematicsData{VehicleID: i, Timestamp: start.Add(time.Duration(i) * time.Second)})
+	}
+	stats := c.Stats()
+	if stats.Len != 10 || stats.Capacity != 10 || stats.CapacityEvictions != 2 || stats.Vehicles != 12 {
+		t.Errorf("Stats() = %+v, want Len 10, Capacity 10, 2 evictions and 12 vehicles", stats)
+	}
+}
+
+func TestShardsShareCapacity(t *testing.T) {
+	c := NewTelematicsDataCache(100, WithShards(16))
+	start := time.Now()
+
+	for i := 0; i < 150; i++ {
+		c.Add(models.TelematicsData{VehicleID: i % 3, Timestamp: start.Add(time.Duration(i) * time.Second), Speed: i})
+	}
+
+	stats := c.Stats()
+	if stats.Len != 100 || stats.CapacityEvictions != 50 {
+		t.Errorf("Stats() = %+v, want Len 100 and 50 evictions with 3 vehicles over 16 shards", stats)
+	}
+	result, err := c.GetRange(start.Add(-time.Second), start.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("GetRange() error = %v", err)
+	}
+	if len(result) != 100 || result[0].Speed != 149 || result[99].Speed != 50 {
+		t.Errorf("GetRange() kept %d records, want the last 100 added", len(result))
+	}
+}
+
+func TestShardsConcurrentAccess(t *testing.T) {
+	c := NewTelematicsDataCache(1000, WithShards(8), WithMaxAge(time.Hour))
+	start := time.Now()
+
+	var wg sync.WaitGroup
+	for w := 0; w < 8; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < 1000; i++ {
+				c.Add(models.TelematicsData{VehicleID: w*10 + i%10, Timestamp: start.Add(time.Duration(i) * time.Millisecond)})
+			}
+		}(w)
+	}
+	for r := 0; r < 4; r++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				result, err := c.GetRange(start.Add(-time.Second), start.Add(time.Hour))
+				for j := 1; err == nil && j < len(result); j++ {
+					if result[j].Timestamp.After(result[j-1].Timestamp) {
+						t.Errorf("GetRange() is not sorted newest first")
+						break
+					}
+				}
+				c.GetLatest()
+				c.GetLatestForVehicle(i % 80)
+				c.ListVehicles()
+				c.Expire(time.Now())
+			}
+		}()
+	}
+	wg.Wait()
+
+	if stats := c.Stats(); stats.Len != 1000 || stats.Evictions != 7000 {
+		t.Errorf("Stats() = %+v, want Len 1000, Evictions 7000", stats)
+	}
+}
+
+// cacheUnderMutex serialises all calls on one mutex, like the cache did
+// before it was sharded, as the baseline for the parallel benchmarks.
+type cacheUnderMutex struct {
+	mx    sync.Mutex
+	cache *TelematicsDataCache
+}
+
+func (c *cacheUnderMutex) Add(telematicsData models.TelematicsData) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	c.cache.Add(telematicsData)
+}
+
+func (c *cacheUnderMutex) GetLatestForVehicle(vehicleID int) (models.TelematicsData, bool) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	return c.cache.GetLatestForVehicle(vehicleID)
+}
+
+func (c *cacheUnderMutex) GetRange(from, to time.Time) ([]models.TelematicsData, error) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	return c.cache.GetRange(from, to)
+}
+
+type benchmarkCache interface {
+	Add(models.TelematicsData)
+	GetLatestForVehicle(int) (models.TelematicsData, bool)
+	GetRange(time.Time, time.Time) ([]models.TelematicsData, error)
+}
+
+// BenchmarkParallel runs many writers and readers at once: every goroutine
+// adds records, and one operation in every readEvery is a read instead, a
+// short range read or a latest position lookup.
+func BenchmarkParallel(b *testing.B) {
+	const size = 100_000
+
+	caches := []struct {
+		name string
+		new  func() benchmarkCache
+	}{
+		{"SingleMutex", func() benchmarkCache {
+			return &cacheUnderMutex{cache: NewTelematicsDataCache(size)}
+		}},
+		{"Shards1", func() benchmarkCache { return NewTelematicsDataCache(size) }},
+		{"Shards16", func() benchmarkCache { return NewTelematicsDataCache(size, WithShards(16)) }},
+		{"Shards64", func() benchmarkCache { return NewTelematicsDataCache(size, WithShards(64)) }},
+	}
+
+	for _, readEvery := range []int{2, 10, 100} {
+		for _, cc := range caches {
+			b.Run(fmt.Sprintf("ReadEvery%d/%s", readEvery, cc.name), func(b *testing.B) {
+				c := cc.new()
+				start := time.Now()
+				for i := 0; i < size; i++ {
+					c.Add(models.TelematicsData{VehicleID: i % 1000, Timestamp: start.Add(time.Duration(i) * time.Millisecond)})
+				}
+
+				var worker atomic.Int64
+				b.ResetTimer()
+				b.RunParallel(func(pb *testing.PB) {
+					w := int(worker.Add(1))
+					for i := 0; pb.Next(); i++ {
+						vehicleID := (w*97 + i) % 1000
+						switch {
+						case i%readEvery != 0:
+							c.Add(models.TelematicsData{VehicleID: vehicleID, Timestamp: start.Add(time.Duration(size+i) * time.Millisecond)})
+						case i%(2*readEvery) == 0:
+							from := start.Add(time.Duration(size-i%size) * time.Millisecond)
+							c.GetRange(from, from.Add(10*time.Millisecond))
+						default:
+							c.GetLatestForVehicle(vehicleID)
+						}
+					}
+				})
+			})
+		}
+	}
+}
@@ -0,0 +1,866 @@
+package cache
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"log"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"telematics-generator/pkg/models"
+)
+
+const (
+	segmentExt          = ".seg"
+	recordHeaderLen     = 8
+	maxRecordLen        = 1 << 10
+	defaultSegmentBytes = 8 << 20
+	defaultSegmentSpan  = time.Hour
+	syncInterval        = time.Second
+	// blockRecords is the number of records per entry of the sparse index
+	// of a segment.
+	blockRecords = 256
+)
+
+var crcTable = crc32.MakeTable(crc32.Castagnoli)
+
+// DiskCache is a DataCacher that keeps records in append-only segment files,
+// so the history survives restarts. Every record is written with its length
+// and checksum; a torn record at the end of the last segment, left by a crash,
+// is cut off when the cache is opened.
+//
+// Segments are rotated by size and by the time span they cover. Only the time
+// and coordinate bounds, the latest record of every vehicle and a sparse
+// index of each segment are kept in memory. The index holds the offset and
+// the time range of every block of blockRecords records, so queries read only
+// the blocks overlapping the requested range and area, and they read them
+// without holding the lock. Whole segments are deleted once they are older
+// than the retention period or the total size exceeds maxBytes, together with
+// the vehicles, latest records and rollups left without stored records. Writes
+// are synced to disk at most once per syncInterval, so a crash loses up to the
+// records of the last interval.
+type DiskCache struct {
+	dir          string
+	retention    time.Duration
+	maxBytes     int64
+	segmentBytes int64
+	segmentSpan  time.Duration
+	now          func() time.Time
+
+	mx       sync.RWMutex
+	opened   time.Time
+	segments []*segment
+	active   *os.File
+	lastSync time.Time
+	latest   models.TelematicsData
+	hasData  bool
+	vehicles map[int]models.TelematicsData
+	rollups  *rollups
+}
+
+type segment struct {
+	id           uint64
+	path         string
+	size         int64
+	count        int
+	minTimestamp time.Time
+	maxTimestamp time.Time
+	area         BoundingBox
+	vehicles     map[int]models.TelematicsData
+	blocks       []block
+}
+
+// block is an entry of the sparse index of a segment: the offset of a run of
+// records and the time range they cover. Late records make the ranges of
+// blocks overlap, so every block is checked.
+type block struct {
+	offset       int64
+	minTimestamp int64
+	maxTimestamp int64
+}
+
+// segmentRead is the part of a segment a query reads, taken under the lock.
+// The spans end at the size of the segment at that time, so records appended
+// later are not read half-written.
+type segmentRead struct {
+	path         string
+	minTimestamp time.Time
+	maxTimestamp time.Time
+	spans        []span
+}
+
+type span struct {
+	start int64
+	end   int64
+}
+
+type DiskOption func(*DiskCache)
+
+// WithDiskClock sets the clock the retention period is measured by, e.g. the
+// one of a simulation. It is the wall clock by default.
+func WithDiskClock(now func() time.Time) DiskOption {
+	return func(c *DiskCache) {
+		c.now = now
+	}
+}
+
+func OpenDiskCache(dir string, retention time.Duration, maxBytes int64, opts ...DiskOption) (*DiskCache, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	c := &DiskCache{
+		dir:          dir,
+		retention:    retention,
+		maxBytes:     maxBytes,
+		segmentBytes: defaultSegmentBytes,
+		segmentSpan:  defaultSegmentSpan,
+		now:          time.Now,
+		vehicles:     make(map[int]models.TelematicsData),
+		rollups:      newRollups(),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	c.opened = c.now()
+
+	if err := c.load(); err != nil {
+		return nil, err
+	}
+	if err := c.applyRetention(c.now()); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (c *DiskCache) load() error {
+	entries, err := os.ReadDir(c.dir)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, segmentExt) {
+			continue
+		}
+		id, err := strconv.ParseUint(strings.TrimSuffix(name, segmentExt), 10, 64)
+		if err != nil {
+			continue
+		}
+		c.segments = append(c.segments, &segment{
+			id:       id,
+			path:     filepath.Join(c.dir, name),
+			vehicles: make(map[int]models.TelematicsData),
+		})
+	}
+	sort.Slice(c.segments, func(i, j int) bool {
+		return c.segments[i].id < c.segments[j].id
+	})
+
+	for i, seg := range c.segments {
+		data, err := os.ReadFile(seg.path)
+		if err != nil {
+			return err
+		}
+		valid := decodeRecords(data, 0, func(data models.TelematicsData, offset int64) bool {
+			seg.add(data, offset)
+			c.remember(data)
+			return true
+		})
+		seg.size = valid
+
+		if i == len(c.segments)-1 {
+			// The last segment is the only one written to, so anything after
+			// the last valid record is a write torn by a crash.
+			if err := os.Truncate(seg.path, valid); err != nil {
+				return err
+			}
+			c.active, err = os.OpenFile(seg.path, os.O_WRONLY|os.O_APPEND, 0o644)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (c *DiskCache) Add(telematicsData models.TelematicsData) {
+	if err := c.append(telematicsData); err != nil {
+		log.Printf("Failed to write telematics data to disk: %v", err)
+	}
+}
+
+func (c *DiskCache) append(telematicsData models.TelematicsData) error {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	record := encodeRecord(telematicsData)
+
+	if c.needsRotation(telematicsData, int64(len(record))) {
+		if err := c.rotate(); err != nil {
+			return err
+		}
+	}
+
+	seg := c.segments[len(c.segments)-1]
+	if _, err := c.active.Write(record); err != nil {
+		// Cut off a partially written record, otherwise every record appended
+		// after it would be unreadable.
+		if truncErr := c.active.Truncate(seg.size); truncErr != nil {
+			return fmt.Errorf("%w (truncate: %v)", err, truncErr)
+		}
+		return err
+	}
+	if time.Since(c.lastSync) >= syncInterval {
+		if err := c.active.Sync(); err != nil {
+			return err
+		}
+		c.lastSync = time.Now()
+	}
+
+	seg.add(telematicsData, seg.size)
+	seg.size += int64(len(record))
+	c.remember(telematicsData)
+
+	return nil
+}
+
+func (c *DiskCache) needsRotation(telematicsData models.TelematicsData, recordLen int64) bool {
+	if c.active == nil {
+		return true
+	}
+
+	seg := c.segments[len(c.segments)-1]
+	if seg.count == 0 {
+		return false
+	}
+
+	return seg.size+recordLen > c.segmentBytes ||
+		telematicsData.Timestamp.Sub(seg.minTimestamp) >= c.segmentSpan
+}
+
+func (c *DiskCache) rotate() error {
+	var id uint64
+	if c.active != nil {
+		if err := c.active.Sync(); err != nil {
+			return err
+		}
+		if err := c.active.Close(); err != nil {
+			return err
+		}
+		c.active = nil
+	}
+	if len(c.segments) > 0 {
+		id = c.segments[len(c.segments)-1].id + 1
+	}
+
+	path := filepath.Join(c.dir, fmt.Sprintf("%020d%s", id, segmentExt))
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return err
+	}
+	c.active = f
+	c.segments = append(c.segments, &segment{
+		id:       id,
+		path:     path,
+		vehicles: make(map[int]models.TelematicsData),
+	})
+
+	return c.applyRetention(c.now())
+}
+
+// applyRetention deletes the oldest segments that are out of the retention
+// period or exceed maxBytes. The segment being written is never deleted.
+func (c *DiskCache) applyRetention(now time.Time) error {
+	var total int64
+	for _, seg := range c.segments {
+		total += seg.size
+	}
+
+	deadline := now.Add(-c.retention)
+	deleted := false
+	defer func() {
+		if deleted {
+			c.prune()
+		}
+	}()
+	for len(c.segments) > 1 {
+		seg := c.segments[0]
+		expired := c.retention > 0 && seg.maxTimestamp.Before(deadline)
+		oversize := c.maxBytes > 0 && total > c.maxBytes
+		if !expired && !oversize {
+			break
+		}
+
+		if err := os.Remove(seg.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+		total -= seg.size
+		c.segments[0] = nil
+		c.segments = c.segments[1:]
+		deleted = true
+	}
+
+	return nil
+}
+
+// prune rebuilds the latest record of every vehicle from the remaining
+// segments after some were deleted. Vehicles without stored records are
+// forgotten, and rollup buckets ending before the remaining records of
+// a vehicle are dropped, as if the cache was opened again.
+func (c *DiskCache) prune() {
+	vehicles := make(map[int]models.TelematicsData)
+	first := make(map[int]time.Time)
+	for _, seg := range c.segments {
+		for vehicleID, latest := range seg.vehicles {
+			if prev, ok := vehicles[vehicleID]; !ok || !latest.Timestamp.Before(prev.Timestamp) {
+				vehicles[vehicleID] = latest
+			}
+			if t, ok := first[vehicleID]; !ok || seg.minTimestamp.Before(t) {
+				first[vehicleID] = seg.minTimestamp
+			}
+		}
+	}
+
+	for vehicleID := range c.vehicles {
+		if _, ok := vehicles[vehicleID]; ok {
+			c.rollups.dropBefore(vehicleID, first[vehicleID])
+		} else {
+			c.rollups.forget(vehicleID)
+		}
+	}
+	c.vehicles = vehicles
+	// The last written record is in the newest non-empty segment, it is gone
+	// only with all the others.
+	if len(vehicles) == 0 {
+		c.latest, c.hasData = models.TelematicsData{}, false
+	}
+}
+
+// Expire deletes the segments out of the retention period. Rotation does it
+// as well, Expire covers the time when nothing is written.
+func (c *DiskCache) Expire(now time.Time) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	if err := c.applyRetention(now); err != nil {
+		log.Printf("Failed to apply disk storage retention: %v", err)
+	}
+}
+
+func (c *DiskCache) remember(telematicsData models.TelematicsData) {
+	c.latest = telematicsData
+	c.hasData = true
+
+	latest, ok := c.vehicles[telematicsData.VehicleID]
+	c.rollups.add(telematicsData, latest, ok)
+	if !ok || !telematicsData.Timestamp.Before(latest.Timestamp) {
+		c.vehicles[telematicsData.VehicleID] = telematicsData
+	}
+}
+
+func (c *DiskCache) GetLatest() (models.TelematicsData, bool) {
+	c.mx.RLock()
+	defer c.mx.RUnlock()
+
+	return c.latest, c.hasData
+}
+
+func (c *DiskCache) GetLatestForVehicle(vehicleID int) (models.TelematicsData, bool) {
+	c.mx.RLock()
+	defer c.mx.RUnlock()
+
+	latest, ok := c.vehicles[vehicleID]
+	return latest, ok
+}
+
+func (c *DiskCache) GetLatestPerVehicle() []models.TelematicsData {
+	c.mx.RLock()
+	defer c.mx.RUnlock()
+
+	result := make([]models.TelematicsData, 0, len(c.vehicles))
+	for _, latest := range c.vehicles {
+		result = append(result, latest)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].VehicleID < result[j].VehicleID
+	})
+
+	return result
+}
+
+func (c *DiskCache) ListVehicles() []int {
+	c.mx.RLock()
+	defer c.mx.RUnlock()
+
+	vehicles := make([]int, 0, len(c.vehicles))
+	for vehicleID := range c.vehicles {
+		vehicles = append(vehicles, vehicleID)
+	}
+	sort.Ints(vehicles)
+
+	return vehicles
+}
+
+// GetRollups returns the rollups kept in memory. They are rebuilt from the
+// segments when the cache is opened.
+func (c *DiskCache) GetRollups(resolution time.Duration, from, to time.Time, vehicleIDs ...int) ([]Rollup, error) {
+	c.mx.RLock()
+	defer c.mx.RUnlock()
+
+	return c.rollups.get(resolution, from, to, vehicleIDs)
+}
+
+func (c *DiskCache) GetRange(from, to time.Time) ([]models.TelematicsData, error) {
+	return c.GetRangeBounds(from, to, ExcludeBoth)
+}
+
+func (c *DiskCache) GetRangeBounds(from, to time.Time, bounds RangeBounds) ([]models.TelematicsData, error) {
+	reads, err := c.rangeReads(-1, nil, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return scan(reads, -1, nil, from, to, bounds)
+}
+
+func (c *DiskCache) GetInArea(area Area, from, to time.Time, bounds RangeBounds) ([]models.TelematicsData, error) {
+	reads, err := c.rangeReads(-1, area, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return scan(reads, -1, area, from, to, bounds)
+}
+
+func (c *DiskCache) GetRangeForVehicle(vehicleID int, from, to time.Time, bounds RangeBounds) ([]models.TelematicsData, error) {
+	reads, err := c.rangeReads(vehicleID, nil, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return scan(reads, vehicleID, nil, from, to, bounds)
+}
+
+// CheckRange returns the error GetRange returns when the range is out of the
+// stored records, or nil.
+func (c *DiskCache) CheckRange(from, to time.Time) error {
+	c.mx.RLock()
+	defer c.mx.RUnlock()
+
+	return c.checkRange(from, to)
+}
+
+func (c *DiskCache) checkRange(from, to time.Time) error {
+	minTimestamp, maxTimestamp, ok := c.bounds(-1)
+	if !ok {
+		return fmt.Errorf("%w. No data is retained", ErrOutOfRange)
+	}
+	if from.After(maxTimestamp) || to.Before(minTimestamp) {
+		return fmt.Errorf("%w. Available range is from %v (timestamp: %v) to %v (timestamp: %v)",
+			ErrOutOfRange, minTimestamp, minTimestamp.UnixNano(), maxTimestamp, maxTimestamp.UnixNano())
+	}
+	return nil
+}
+
+// rangeReads checks the range against the stored records of the vehicle, or
+// of all vehicles if vehicleID is negative, and returns the parts of the
+// segments overlapping the range and the area to read.
+func (c *DiskCache) rangeReads(vehicleID int, area Area, from, to time.Time) ([]segmentRead, error) {
+	c.mx.RLock()
+	defer c.mx.RUnlock()
+
+	if from.After(to) {
+		return nil, errors.New("the 'from' timestamp must be earlier than the 'to' timestamp")
+	}
+
+	if vehicleID >= 0 {
+		minTimestamp, maxTimestamp, ok := c.bounds(vehicleID)
+		if !ok {
+			return nil, fmt.Errorf("%w %d: no data available", ErrUnknownVehicle, vehicleID)
+		}
+		if from.After(maxTimestamp) || to.Before(minTimestamp) {
+			return nil, fmt.Errorf("%w. Available range for vehicle %d is from %v (timestamp: %v) to %v (timestamp: %v)",
+				ErrOutOfRange, vehicleID, minTimestamp, minTimestamp.UnixNano(), maxTimestamp, maxTimestamp.UnixNano())
+		}
+	} else if err := c.checkRange(from, to); err != nil {
+		return nil, err
+	}
+
+	var reads []segmentRead
+	for _, seg := range c.segments {
+		if seg.count == 0 || !seg.has(vehicleID) || seg.maxTimestamp.Before(from) || seg.minTimestamp.After(to) {
+			continue
+		}
+		if area != nil && !seg.area.Intersects(area.Bounds()) {
+			continue
+		}
+		reads = append(reads, seg.read(from.UnixNano(), to.UnixNano()))
+	}
+
+	return reads, nil
+}
+
+// GetBracket reads the segments of the vehicle closest to t first and stops
+// once the remaining segments cannot hold closer records.
+func (c *DiskCache) GetBracket(vehicleID int, t time.Time) (Bracket, error) {
+	before, after, err := c.bracketReads(vehicleID, t)
+	if err != nil {
+		return Bracket{}, err
+	}
+
+	// The closest record before t can be at most at the end of the segment,
+	// or at t if the segment spans it, and likewise after t.
+	sort.Slice(before, func(i, j int) bool {
+		return minTime(before[i].maxTimestamp, t).After(minTime(before[j].maxTimestamp, t))
+	})
+	sort.Slice(after, func(i, j int) bool {
+		return maxTime(after[i].minTimestamp, t).Before(maxTime(after[j].minTimestamp, t))
+	})
+
+	var b Bracket
+	for _, r := range before {
+		if b.HasBefore && minTime(r.maxTimestamp, t).Before(b.Before.Timestamp) {
+			break
+		}
+		err := r.read(func(data models.TelematicsData) bool {
+			if data.VehicleID == vehicleID && !data.Timestamp.After(t) && (!b.HasBefore || !data.Timestamp.Before(b.Before.Timestamp)) {
+				b.Before, b.HasBefore = data, true
+			}
+			return true
+		})
+		if err != nil {
+			return Bracket{}, err
+		}
+	}
+	for _, r := range after {
+		if b.HasAfter && maxTime(r.minTimestamp, t).After(b.After.Timestamp) {
+			break
+		}
+		err := r.read(func(data models.TelematicsData) bool {
+			if data.VehicleID == vehicleID && !data.Timestamp.Before(t) && (!b.HasAfter || data.Timestamp.Before(b.After.Timestamp)) {
+				b.After, b.HasAfter = data, true
+			}
+			return true
+		})
+		if err != nil {
+			return Bracket{}, err
+		}
+	}
+
+	return b, nil
+}
+
+// bracketReads returns the parts of the segments of the vehicle that can hold
+// its records not after t and not before t.
+func (c *DiskCache) bracketReads(vehicleID int, t time.Time) ([]segmentRead, []segmentRead, error) {
+	c.mx.RLock()
+	defer c.mx.RUnlock()
+
+	if _, ok := c.vehicles[vehicleID]; !ok {
+		return nil, nil, fmt.Errorf("%w %d: no data available", ErrUnknownVehicle, vehicleID)
+	}
+
+	var before, after []segmentRead
+	for _, seg := range c.segments {
+		if seg.count == 0 || !seg.has(vehicleID) {
+			continue
+		}
+		if !seg.minTimestamp.After(t) {
+			before = append(before, seg.read(math.MinInt64, t.UnixNano()))
+		}
+		if !seg.maxTimestamp.Before(t) {
+			after = append(after, seg.read(t.UnixNano(), math.MaxInt64))
+		}
+	}
+
+	return before, after, nil
+}
+
+func minTime(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
+
+// bounds returns the time range of the stored records of the vehicle, or of
+// all vehicles if vehicleID is negative. Without records both ends are the
+// time the cache was opened, as in TelematicsDataCache.
+func (c *DiskCache) bounds(vehicleID int) (time.Time, time.Time, bool) {
+	minTimestamp, maxTimestamp := c.opened, c.opened
+	found := false
+	for _, seg := range c.segments {
+		if seg.count == 0 || !seg.has(vehicleID) {
+			continue
+		}
+		if !found || seg.minTimestamp.Before(minTimestamp) {
+			minTimestamp = seg.minTimestamp
+		}
+		if !found || seg.maxTimestamp.After(maxTimestamp) {
+			maxTimestamp = seg.maxTimestamp
+		}
+		found = true
+	}
+
+	return minTimestamp, maxTimestamp, found
+}
+
+// scan reads the parts of the segments and returns the matching records
+// newest first. Records with equal timestamps are returned in reverse order of
+// writing, like TelematicsDataCache does. A negative vehicleID or a nil area
+// match any vehicle or location.
+func scan(reads []segmentRead, vehicleID int, area Area, from, to time.Time, bounds RangeBounds) ([]models.TelematicsData, error) {
+	var result []models.TelematicsData
+	for _, r := range reads {
+		err := r.read(func(data models.TelematicsData) bool {
+			if (vehicleID < 0 || data.VehicleID == vehicleID) && inRange(data.Timestamp, from, to, bounds) &&
+				(area == nil || area.Contains(point(data))) {
+				result = append(result, data)
+			}
+			return true
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for i, j := 0, len(result)-1; i < j; i, j = i+1, j-1 {
+		result[i], result[j] = result[j], result[i]
+	}
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].Timestamp.After(result[j].Timestamp)
+	})
+
+	return result, nil
+}
+
+func (c *DiskCache) Close() error {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	if c.active == nil {
+		return nil
+	}
+	if err := c.active.Sync(); err != nil {
+		return err
+	}
+	err := c.active.Close()
+	c.active = nil
+
+	return err
+}
+
+// add accounts for the record written at offset.
+func (s *segment) add(telematicsData models.TelematicsData, offset int64) {
+	ts := telematicsData.Timestamp.UnixNano()
+	if s.count%blockRecords == 0 {
+		s.blocks = append(s.blocks, block{offset: offset, minTimestamp: ts, maxTimestamp: ts})
+	} else {
+		b := &s.blocks[len(s.blocks)-1]
+		if ts < b.minTimestamp {
+			b.minTimestamp = ts
+		}
+		if ts > b.maxTimestamp {
+			b.maxTimestamp = ts
+		}
+	}
+
+	if s.count == 0 || telematicsData.Timestamp.Before(s.minTimestamp) {
+		s.minTimestamp = telematicsData.Timestamp
+	}
+	if s.count == 0 || telematicsData.Timestamp.After(s.maxTimestamp) {
+		s.maxTimestamp = telematicsData.Timestamp
+	}
+	if s.count == 0 {
+		s.area = BoundingBox{
+			MinLatitude:  telematicsData.Latitude,
+			MinLongitude: telematicsData.Longitude,
+			MaxLatitude:  telematicsData.Latitude,
+			MaxLongitude: telematicsData.Longitude,
+		}
+	}
+	s.area.MinLatitude = math.Min(s.area.MinLatitude, telematicsData.Latitude)
+	s.area.MaxLatitude = math.Max(s.area.MaxLatitude, telematicsData.Latitude)
+	s.area.MinLongitude = math.Min(s.area.MinLongitude, telematicsData.Longitude)
+	s.area.MaxLongitude = math.Max(s.area.MaxLongitude, telematicsData.Longitude)
+	if latest, ok := s.vehicles[telematicsData.VehicleID]; !ok || !telematicsData.Timestamp.Before(latest.Timestamp) {
+		s.vehicles[telematicsData.VehicleID] = telematicsData
+	}
+	s.count++
+}
+
+// read returns the blocks of the segment holding records between from and to.
+func (s *segment) read(from, to int64) segmentRead {
+	r := segmentRead{path: s.path, minTimestamp: s.minTimestamp, maxTimestamp: s.maxTimestamp}
+	for i, b := range s.blocks {
+		if b.maxTimestamp < from || b.minTimestamp > to {
+			continue
+		}
+		end := s.size
+		if i+1 < len(s.blocks) {
+			end = s.blocks[i+1].offset
+		}
+		if n := len(r.spans); n > 0 && r.spans[n-1].end == b.offset {
+			r.spans[n-1].end = end
+		} else {
+			r.spans = append(r.spans, span{start: b.offset, end: end})
+		}
+	}
+	return r
+}
+
+// read calls fn for every record of the spans until fn returns false. A
+// segment deleted by retention after the read was planned has no records.
+func (r segmentRead) read(fn func(models.TelematicsData) bool) error {
+	if len(r.spans) == 0 {
+		return nil
+	}
+	f, err := os.Open(r.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	for _, sp := range r.spans {
+		data := make([]byte, sp.end-sp.start)
+		if _, err := f.ReadAt(data, sp.start); err != nil {
+			return err
+		}
+		stopped := false
+		decodeRecords(data, sp.start, func(telematicsData models.TelematicsData, _ int64) bool {
+			stopped = !fn(telematicsData)
+			return !stopped
+		})
+		if stopped {
+			return nil
+		}
+	}
+	return nil
+}
+
+func (s *segment) has(vehicleID int) bool {
+	if vehicleID < 0 {
+		return true
+	}
+	_, ok := s.vehicles[vehicleID]
+	return ok
+}
+
+func inRange(ts, from, to time.Time, bounds RangeBounds) bool {
+	if ts.Before(from) || ts.After(to) {
+		return false
+	}
+	if ts.Equal(from) && bounds&IncludeFrom == 0 {
+		return false
+	}
+	if ts.Equal(to) && bounds&IncludeTo == 0 {
+		return false
+	}
+	return true
+}
+
+// readSegment calls fn for every valid record of the segment file until fn
+// returns false, and returns the length of the valid part of the file.
+func readSegment(path string, fn func(models.TelematicsData) bool) (int64, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+
+	return decodeRecords(data, 0, func(telematicsData models.TelematicsData, _ int64) bool {
+		return fn(telematicsData)
+	}), nil
+}
+
+// decodeRecords calls fn for every valid record of data, read from the file
+// at base, with its offset in the file until fn returns false. It returns the
+// offset after the last record decoded.
+func decodeRecords(data []byte, base int64, fn func(models.TelematicsData, int64) bool) int64 {
+	var offset int64
+	for {
+		telematicsData, n, err := decodeRecord(data[offset:])
+		if err != nil {
+			return base + offset
+		}
+		start := offset
+		offset += int64(n)
+		if !fn(telematicsData, base+start) {
+			return base + offset
+		}
+	}
+}
+
+// encodeRecord returns the record as the payload length, the CRC-32C of the
+// payload and the payload itself.
+func encodeRecord(telematicsData models.TelematicsData) []byte {
+	record := make([]byte, recordHeaderLen, recordHeaderLen+4*binary.MaxVarintLen64+16)
+	record = binary.AppendVarint(record, int64(telematicsData.VehicleID))
+	record = binary.AppendVarint(record, telematicsData.Timestamp.UnixNano())
+	record = binary.AppendVarint(record, int64(telematicsData.Speed))
+	record = binary.LittleEndian.AppendUint64(record, math.Float64bits(telematicsData.Latitude))
+	record = binary.LittleEndian.AppendUint64(record, math.Float64bits(telematicsData.Longitude))
+
+	payload := record[recordHeaderLen:]
+	binary.LittleEndian.PutUint32(record[0:4], uint32(len(payload)))
+	binary.LittleEndian.PutUint32(record[4:8], crc32.Checksum(payload, crcTable))
+
+	return record
+}
+
+func decodeRecord(data []byte) (models.TelematicsData, int, error) {
+	if len(data) < recordHeaderLen {
+		return models.TelematicsData{}, 0, io.ErrUnexpectedEOF
+	}
+	length := int(binary.LittleEndian.Uint32(data[0:4]))
+	if length > maxRecordLen || len(data) < recordHeaderLen+length {
+		return models.TelematicsData{}, 0, io.ErrUnexpectedEOF
+	}
+	payload := data[recordHeaderLen : recordHeaderLen+length]
+	if crc32.Checksum(payload, crcTable) != binary.LittleEndian.Uint32(data[4:8]) {
+		return models.TelematicsData{}, 0, errors.New("record checksum mismatch")
+	}
+
+	var values [3]int64
+	for i := range values {
+		v, n := binary.Varint(payload)
+		if n <= 0 {
+			return models.TelematicsData{}, 0, errors.New("malformed record")
+		}
+		values[i] = v
+		payload = payload[n:]
+	}
+	if len(payload) < 16 {
+		return models.TelematicsData{}, 0, errors.New("malformed record")
+	}
+
+	return models.TelematicsData{
+		VehicleID: int(values[0]),
+		Timestamp: time.Unix(0, values[1]),
+		Speed:     int(values[2]),
+		Latitude:  math.Float64frombits(binary.LittleEndian.Uint64(payload[0:8])),
+		Longitude: math.Float64frombits(binary.LittleEndian.Uint64(payload[8:16])),
+	}, recordHeaderLen + length, nil
+}
@@ -0,0 +1,384 @@
+package cache
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"telematics-generator/pkg/clock"
+	"telematics-generator/pkg/models"
+	"testing"
+	"time"
+)
+
+func TestDiskCacheSurvivesReopen(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Now()
+
+	c, err := OpenDiskCache(dir, 0, 0)
+	if err != nil {
+		t.Fatalf("OpenDiskCache() error = %v", err)
+	}
+	var added []models.TelematicsData
+	for i := 0; i < 5; i++ {
+		data := models.TelematicsData{
+			VehicleID: i % 2,
+			Timestamp: now.Add(time.Duration(i) * time.Second),
+			Speed:     i,
+			Latitude:  50.45 + float64(i),
+			Longitude: 30.52 - float64(i),
+		}
+		added = append(added, data)
+		c.Add(data)
+	}
+	if err := c.Close(); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+
+	c, err = OpenDiskCache(dir, 0, 0)
+	if err != nil {
+		t.Fatalf("OpenDiskCache() error = %v", err)
+	}
+	defer c.Close()
+
+	latest, ok := c.GetLatest()
+	if !ok || latest.Speed != 4 || !latest.Timestamp.Equal(added[4].Timestamp) {
+		t.Errorf("GetLatest() = %v, want %v", latest, added[4])
+	}
+
+	result, err := c.GetRange(added[0].Timestamp, added[4].Timestamp)
+	if err != nil {
+		t.Fatalf("GetRange() error = %v", err)
+	}
+	if len(result) != 3 || result[0].Speed != 3 || result[2].Speed != 1 {
+		t.Errorf("GetRange() = %v, want records 3, 2, 1", result)
+	}
+	if result[0].Latitude != added[3].Latitude || result[0].Longitude != added[3].Longitude {
+		t.Errorf("GetRange()[0] = %v, want %v", result[0], added[3])
+	}
+
+	result, err = c.GetRangeForVehicle(1, added[0].Timestamp, added[4].Timestamp, IncludeBoth)
+	if err != nil {
+		t.Fatalf("GetRangeForVehicle() error = %v", err)
+	}
+	if len(result) != 2 || result[0].Speed != 3 || result[1].Speed != 1 {
+		t.Errorf("GetRangeForVehicle() = %v, want records 3, 1", result)
+	}
+	if _, err := c.GetRangeForVehicle(7, now, now, IncludeBoth); !errors.Is(err, ErrUnknownVehicle) {
+		t.Errorf("GetRangeForVehicle() of an unknown vehicle error = %v, want ErrUnknownVehicle", err)
+	}
+
+	if got := c.ListVehicles(); len(got) != 2 {
+		t.Errorf("ListVehicles() = %v, want [0 1]", got)
+	}
+
+	c.Add(models.TelematicsData{VehicleID: 5, Timestamp: now.Add(time.Minute)})
+	if latest, ok := c.GetLatestForVehicle(5); !ok || !latest.Timestamp.Equal(now.Add(time.Minute)) {
+		t.Errorf("GetLatestForVehicle() after reopen = %v, %v", latest, ok)
+	}
+}
+
+func TestDiskCacheCutsTornRecord(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Now()
+
+	c, err := OpenDiskCache(dir, 0, 0)
+	if err != nil {
+		t.Fatalf("OpenDiskCache() error = %v", err)
+	}
+	c.Add(models.TelematicsData{VehicleID: 1, Timestamp: now, Speed: 1})
+	c.Add(models.TelematicsData{VehicleID: 1, Timestamp: now.Add(time.Second), Speed: 2})
+	c.Close()
+
+	path := c.segments[0].path
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Truncate(path, info.Size()-3); err != nil {
+		t.Fatal(err)
+	}
+
+	c, err = OpenDiskCache(dir, 0, 0)
+	if err != nil {
+		t.Fatalf("OpenDiskCache() error = %v", err)
+	}
+	defer c.Close()
+
+	c.Add(models.TelematicsData{VehicleID: 1, Timestamp: now.Add(2 * time.Second), Speed: 3})
+
+	result, err := c.GetRangeBounds(now, now.Add(time.Minute), IncludeBoth)
+	if err != nil {
+		t.Fatalf("GetRangeBounds() error = %v", err)
+	}
+	if len(result) != 2 || result[0].Speed != 3 || result[1].Speed != 1 {
+		t.Errorf("GetRangeBounds() = %v, want records 3 and 1", result)
+	}
+}
+
+func TestDiskCacheRetention(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Now()
+
+	c, err := OpenDiskCache(dir, time.Hour, 0)
+	if err != nil {
+		t.Fatalf("OpenDiskCache() error = %v", err)
+	}
+	c.segmentSpan = time.Minute
+
+	for i := 0; i < 5; i++ {
+		c.Add(models.TelematicsData{VehicleID: 1, Timestamp: now.Add(time.Duration(i-4)*time.Hour + 30*time.Minute)})
+	}
+
+	segments, _ := filepath.Glob(filepath.Join(dir, "*"+segmentExt))
+	if len(segments) != 2 {
+		t.Errorf("expected 2 segments within the retention period, got %v", segments)
+	}
+	if _, err := c.GetRange(now.Add(-5*time.Hour), now.Add(-2*time.Hour)); err == nil {
+		t.Errorf("GetRange() of an expired range should return an error")
+	}
+	c.Close()
+
+	c, err = OpenDiskCache(dir, 0, 1)
+	if err != nil {
+		t.Fatalf("OpenDiskCache() error = %v", err)
+	}
+	defer c.Close()
+	c.segmentBytes = 1
+
+	for i := 0; i < 3; i++ {
+		c.Add(models.TelematicsData{VehicleID: 2, Timestamp: now.Add(time.Duration(i) * time.Second)})
+	}
+	if len(c.segments) != 1 {
+		t.Errorf("expected only the active segment to be kept with maxBytes 1, got %v", len(c.segments))
+	}
+}
+
+func TestDiskCacheRetentionFollowsClock(t *testing.T) {
+	dir := t.TempDir()
+	simClock, _ := clock.New(time.Second, 1)
+	simClock.Jump(24 * time.Hour)
+	start := simClock.Pause().Now
+
+	c, err := OpenDiskCache(dir, time.Hour, 0, WithDiskClock(simClock.Now))
+	if err != nil {
+		t.Fatalf("OpenDiskCache() error = %v", err)
+	}
+	defer c.Close()
+	c.segmentSpan = time.Minute
+
+	// The records are a day ahead of the wall clock, so only the simulation
+	// clock can put them out of the retention period.
+	for i := 0; i < 5; i++ {
+		c.Add(models.TelematicsData{VehicleID: 1, Timestamp: start.Add(time.Duration(i-4)*time.Hour + 30*time.Minute)})
+	}
+	if len(c.segments) != 2 {
+		t.Errorf("expected 2 segments within the retention period by the clock, got %v", len(c.segments))
+	}
+
+	simClock.Jump(2 * time.Hour)
+	c.Expire(simClock.Now())
+	if len(c.segments) != 1 {
+		t.Errorf("expected only the active segment after the clock jumped, got %v", len(c.segments))
+	}
+}
+
+func TestDiskCacheRetentionPrunesVehicles(t *testing.T) {
+	now := time.Now()
+	c, err := OpenDiskCache(t.TempDir(), time.Hour, 0, WithDiskClock(func() time.Time { return now }))
+	if err != nil {
+		t.Fatalf("OpenDiskCache() error = %v", err)
+	}
+	defer c.Close()
+	c.segmentSpan = time.Minute
+
+	// Vehicle 1 reports only in the first segment, vehicle 2 in both.
+	old := now.Add(-2 * time.Hour)
+	c.Add(models.TelematicsData{VehicleID: 1, Timestamp: old})
+	c.Add(models.TelematicsData{VehicleID: 2, Timestamp: old.Add(time.Second), Speed: 10})
+	c.Add(models.TelematicsData{VehicleID: 2, Timestamp: now, Speed: 20})
+
+	if vehicles := c.ListVehicles(); len(vehicles) != 1 || vehicles[0] != 2 {
+		t.Errorf("ListVehicles() = %v, want only vehicle 2", vehicles)
+	}
+	if _, ok := c.GetLatestForVehicle(1); ok {
+		t.Errorf("GetLatestForVehicle(1) found a vehicle without stored records")
+	}
+	if latest, ok := c.GetLatestForVehicle(2); !ok || latest.Speed != 20 {
+		t.Errorf("GetLatestForVehicle(2) = %+v, %v, want the stored record", latest, ok)
+	}
+	if _, err := c.GetBracket(1, old); !errors.Is(err, ErrUnknownVehicle) {
+		t.Errorf("GetBracket(1) error = %v, want ErrUnknownVehicle", err)
+	}
+	rollups, err := c.GetRollups(MinuteResolution, old.Add(-time.Hour), now.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("GetRollups() error = %v", err)
+	}
+	if len(rollups) != 1 || rollups[0].VehicleID != 2 || rollups[0].MaxSpeed != 20 {
+		t.Errorf("GetRollups() = %+v, want the bucket of the stored record only", rollups)
+	}
+}
+
+func TestDiskCacheCheckRangeEmpty(t *testing.T) {
+	c, err := OpenDiskCache(t.TempDir(), 0, 0)
+	if err != nil {
+		t.Fatalf("OpenDiskCache() error = %v", err)
+	}
+	defer c.Close()
+
+	memory := NewTelematicsDataCache(10)
+	now := time.Now()
+	want := memory.CheckRange(now.Add(-time.Hour), now)
+	if err := c.CheckRange(now.Add(-time.Hour), now); err == nil || err.Error() != want.Error() {
+		t.Errorf("CheckRange() of an empty store error = %v, want %v", err, want)
+	}
+}
+
+func TestDiskCacheSparseIndex(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Now()
+
+	c, err := OpenDiskCache(dir, 0, 0)
+	if err != nil {
+		t.Fatalf("OpenDiskCache() error = %v", err)
+	}
+	for i := 0; i < 10*blockRecords; i++ {
+		c.Add(models.TelematicsData{VehicleID: i % 3, Timestamp: now.Add(time.Duration(i) * time.Millisecond), Speed: i})
+	}
+	// A late record widens the block it is written to.
+	c.Add(models.TelematicsData{VehicleID: 1, Timestamp: now.Add(time.Millisecond / 2), Speed: -1})
+	c.Close()
+
+	// The index is rebuilt when the segment is loaded.
+	c, err = OpenDiskCache(dir, 0, 0)
+	if err != nil {
+		t.Fatalf("OpenDiskCache() error = %v", err)
+	}
+	defer c.Close()
+
+	seg := c.segments[0]
+	if len(seg.blocks) != 11 {
+		t.Fatalf("segment has %d index blocks, want 11", len(seg.blocks))
+	}
+	from := now.Add(time.Duration(3*blockRecords) * time.Millisecond)
+	to := now.Add(time.Duration(4*blockRecords-1) * time.Millisecond)
+	r := seg.read(from.UnixNano(), to.UnixNano())
+	if len(r.spans) != 1 || r.spans[0].start != seg.blocks[3].offset || r.spans[0].end != seg.blocks[4].offset {
+		t.Errorf("read() spans = %v, want block 3 only", r.spans)
+	}
+
+	result, err := c.GetRangeBounds(from, to, IncludeBoth)
+	if err != nil {
+		t.Fatalf("GetRangeBounds() error = %v", err)
+	}
+	if len(result) != blockRecords || result[0].Speed != 4*blockRecords-1 || result[blockRecords-1].Speed != 3*blockRecords {
+		t.Errorf("GetRangeBounds() returned %d records, want block 3 only", len(result))
+	}
+	result, _ = c.GetRangeForVehicle(1, now, now.Add(time.Millisecond), IncludeBoth)
+	if len(result) != 2 || result[0].Speed != 1 || result[1].Speed != -1 {
+		t.Errorf("GetRangeForVehicle() = %v, want the late record found through the index", result)
+	}
+}
+
+func TestDiskCacheConcurrentAccess(t *testing.T) {
+	c, err := OpenDiskCache(t.TempDir(), time.Hour, 0)
+	if err != nil {
+		t.Fatalf("OpenDiskCache() error = %v", err)
+	}
+	defer c.Close()
+	c.segmentSpan = time.Second
+	start := time.Now().Add(-2 * time.Hour)
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 500; i++ {
+			c.Add(models.TelematicsData{VehicleID: i % 4, Timestamp: start.Add(time.Duration(i) * 30 * time.Second)})
+		}
+	}()
+	for running := true; running; {
+		select {
+		case <-done:
+			running = false
+		default:
+		}
+		result, err := c.GetRange(start.Add(-time.Hour), start.Add(24*time.Hour))
+		if err != nil && !errors.Is(err, ErrOutOfRange) {
+			t.Fatalf("GetRange() error = %v", err)
+		}
+		for i := 1; i < len(result); i++ {
+			if result[i].Timestamp.After(result[i-1].Timestamp) {
+				t.Fatalf("GetRange() is not sorted newest first")
+			}
+		}
+		c.GetBracket(1, start.Add(time.Hour))
+	}
+}
+
+func TestDiskCacheGetInArea(t *testing.T) {
+	c, err := OpenDiskCache(t.TempDir(), 0, 0)
+	if err != nil {
+		t.Fatalf("OpenDiskCache() error = %v", err)
+	}
+	defer c.Close()
+
+	now := time.Now()
+	c.Add(models.TelematicsData{VehicleID: 1, Timestamp: now, Latitude: 55.75, Longitude: 37.61})
+	c.Add(models.TelematicsData{VehicleID: 2, Timestamp: now, Latitude: 59.93, Longitude: 30.31})
+
+	result, err := c.GetInArea(Circle{Center: Point{55.75, 37.62}, Radius: 5000}, now.Add(-time.Second), now.Add(time.Second), ExcludeBoth)
+	if err != nil {
+		t.Fatalf("GetInArea() error = %v", err)
+	}
+	if len(result) != 1 || result[0].VehicleID != 1 {
+		t.Errorf("GetInArea() = %v, want the record of vehicle 1", result)
+	}
+}
+
+func TestGetBracket(t *testing.T) {
+	disk, err := OpenDiskCache(t.TempDir(), 0, 0)
+	if err != nil {
+		t.Fatalf("OpenDiskCache() error = %v", err)
+	}
+	defer disk.Close()
+
+	caches := map[string]DataCacher{
+		"memory": NewTelematicsDataCache(100, WithShards(4)),
+		"disk":   disk,
+	}
+	start := time.Now().Add(-24 * time.Hour)
+
+	for name, c := range caches {
+		// Hours apart, so the disk cache puts them in separate segments.
+		for _, hours := range []int{0, 3, 1, 5, 2} {
+			c.Add(models.TelematicsData{VehicleID: 1, Timestamp: start.Add(time.Duration(hours) * time.Hour), Speed: hours})
+			c.Add(models.TelematicsData{VehicleID: 2, Timestamp: start.Add(time.Duration(hours)*time.Hour + time.Minute)})
+		}
+
+		b, err := c.GetBracket(1, start.Add(150*time.Minute))
+		if err != nil {
+			t.Fatalf("%v: GetBracket() error = %v", name, err)
+		}
+		if !b.HasBefore || !b.HasAfter || b.Before.Speed != 2 || b.After.Speed != 3 {
+			t.Errorf("%v: GetBracket() = %+v, want records 2 and 3", name, b)
+		}
+
+		b, _ = c.GetBracket(1, start.Add(3*time.Hour))
+		if b.Before.Speed != 3 || b.After.Speed != 3 {
+			t.Errorf("%v: GetBracket() at a sample = %+v, want the sample on both sides", name, b)
+		}
+
+		b, _ = c.GetBracket(1, start.Add(6*time.Hour))
+		if !b.HasBefore || b.HasAfter || b.Before.Speed != 5 {
+			t.Errorf("%v: GetBracket() after the last sample = %+v, want record 5 before only", name, b)
+		}
+
+		b, _ = c.GetBracket(1, start.Add(-time.Hour))
+		if b.HasBefore || !b.HasAfter || b.After.Speed != 0 {
+			t.Errorf("%v: GetBracket() before the first sample = %+v, want record 0 after only", name, b)
+		}
+
+		if _, err := c.GetBracket(3, start); !errors.Is(err, ErrUnknownVehicle) {
+			t.Errorf("%v: GetBracket() of an unknown vehicle error = %v, want ErrUnknownVehicle", name, err)
+		}
+	}
+}
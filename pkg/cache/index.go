@@ -0,0 +1,189 @@
+package cache
+
+import "sort"
+
+const maxSegmentLen = 1024
+
+type indexEntry struct {
+	timestamp int64
+	seq       uint64
+}
+
+func (e indexEntry) less(other indexEntry) bool {
+	if e.timestamp != other.timestamp {
+		return e.timestamp < other.timestamp
+	}
+	return e.seq < other.seq
+}
+
+// timeIndex keeps entries ordered by timestamp and then by sequence number.
+// Entries live in sorted segments of bounded length: lookups are two binary
+// searches, while inserting a late point only shifts a single segment.
+type timeIndex struct {
+	segments [][]indexEntry
+	size     int
+}
+
+// position addresses an entry of the index. A position with seg equal to
+// len(segments) is the end of the index.
+type position struct {
+	seg int
+	off int
+}
+
+func (x *timeIndex) len() int {
+	return x.size
+}
+
+func (x *timeIndex) first() (indexEntry, bool) {
+	if x.size == 0 {
+		return indexEntry{}, false
+	}
+	return x.segments[0][0], true
+}
+
+func (x *timeIndex) last() (indexEntry, bool) {
+	if x.size == 0 {
+		return indexEntry{}, false
+	}
+	seg := x.segments[len(x.segments)-1]
+	return seg[len(seg)-1], true
+}
+
+func (x *timeIndex) insert(e indexEntry) {
+	x.size++
+
+	if len(x.segments) == 0 {
+		x.segments = append(x.segments, []indexEntry{e})
+		return
+	}
+
+	i := sort.Search(len(x.segments), func(i int) bool {
+		seg := x.segments[i]
+		return e.less(seg[len(seg)-1])
+	})
+	if i == len(x.segments) {
+		i--
+	}
+
+	seg := x.segments[i]
+	j := sort.Search(len(seg), func(j int) bool {
+		return e.less(seg[j])
+	})
+	seg = append(seg, indexEntry{})
+	copy(seg[j+1:], seg[j:])
+	seg[j] = e
+
+	if len(seg) < maxSegmentLen {
+		x.segments[i] = seg
+		return
+	}
+
+	half := len(seg) / 2
+	left := append([]indexEntry(nil), seg[:half]...)
+	right := append([]indexEntry(nil), seg[half:]...)
+	x.segments = append(x.segments, nil)
+	copy(x.segments[i+2:], x.segments[i+1:])
+	x.segments[i] = left
+	x.segments[i+1] = right
+}
+
+func (x *timeIndex) remove(e indexEntry) bool {
+	p := x.search(func(other indexEntry) bool {
+		return !other.less(e)
+	})
+	if p.seg == len(x.segments) || x.segments[p.seg][p.off] != e {
+		return false
+	}
+
+	x.size--
+	seg := x.segments[p.seg]
+	if len(seg) == 1 {
+		if p.seg == 0 {
+			x.segments[0] = nil
+			x.segments = x.segments[1:]
+		} else {
+			x.segments = append(x.segments[:p.seg], x.segments[p.seg+1:]...)
+		}
+		return true
+	}
+
+	if p.off == 0 {
+		x.segments[p.seg] = seg[1:]
+	} else {
+		x.segments[p.seg] = append(seg[:p.off], seg[p.off+1:]...)
+	}
+	return true
+}
+
+// search returns the position of the first entry for which ok returns true.
+// ok must be false for a prefix of the index and true for the rest of it.
+func (x *timeIndex) search(ok func(indexEntry) bool) position {
+	i := sort.Search(len(x.segments), func(i int) bool {
+		seg := x.segments[i]
+		return ok(seg[len(seg)-1])
+	})
+	if i == len(x.segments) {
+		return position{seg: i}
+	}
+
+	seg := x.segments[i]
+	return position{seg: i, off: sort.Search(len(seg), func(j int) bool {
+		return ok(seg[j])
+	})}
+}
+
+// lowerBound returns the position of the first entry with a timestamp not
+// earlier than ts, or later than ts if inclusive is false.
+func (x *timeIndex) lowerBound(ts int64, inclusive bool) position {
+	if inclusive {
+		return x.search(func(e indexEntry) bool { return e.timestamp >= ts })
+	}
+	return x.search(func(e indexEntry) bool { return e.timestamp > ts })
+}
+
+// ascend calls fn for the entries in [from, to) in ascending order until fn
+// returns false.
+func (x *timeIndex) ascend(from, to position, fn func(indexEntry) bool) {
+	for p := from; p.seg < len(x.segments) && p.before(to); p = x.next(p) {
+		if !fn(x.segments[p.seg][p.off]) {
+			return
+		}
+	}
+}
+
+// descend calls fn for the entries in [from, to) in descending order until fn
+// returns false.
+func (x *timeIndex) descend(from, to position, fn func(indexEntry) bool) {
+	for p := x.prev(to); p.seg >= 0 && !p.before(from); p = x.prev(p) {
+		if !fn(x.segments[p.seg][p.off]) {
+			return
+		}
+	}
+}
+
+func (x *timeIndex) next(p position) position {
+	p.off++
+	if p.off == len(x.segments[p.seg]) {
+		return position{seg: p.seg + 1}
+	}
+	return p
+}
+
+func (x *timeIndex) prev(p position) position {
+	if p.off > 0 {
+		p.off--
+		return p
+	}
+	if p.seg == 0 {
+		return position{seg: -1}
+	}
+	return position{seg: p.seg - 1, off: len(x.segments[p.seg-1]) - 1}
+}
+
+func (p position) before(other position) bool {
+	if p.seg != other.seg {
+		return p.seg < other.seg
+	}
+	return p.off < other.off
+}
@@ -0,0 +1,185 @@
+package cache
+
+import (
+	"math/rand"
+	"sort"
+	"telematics-generator/pkg/models"
+	"testing"
+	"time"
+)
+
+func TestTimeIndexMatchesSortedSlice(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+
+	var x timeIndex
+	var want []indexEntry
+	for seq := uint64(0); seq < 20000; seq++ {
+		e := indexEntry{timestamp: rnd.Int63n(5000), seq: seq}
+		x.insert(e)
+		want = append(want, e)
+
+		if rnd.Intn(3) == 0 {
+			i := rnd.Intn(len(want))
+			if !x.remove(want[i]) {
+				t.Fatalf("remove(%v) did not find the entry", want[i])
+			}
+			want = append(want[:i], want[i+1:]...)
+		}
+	}
+	sort.Slice(want, func(i, j int) bool { return want[i].less(want[j]) })
+
+	if x.len() != len(want) {
+		t.Fatalf("len() = %v, want %v", x.len(), len(want))
+	}
+	if len(x.segments) < 2 {
+		t.Fatalf("expected the index to be split into segments, got %v", len(x.segments))
+	}
+	if x.remove(indexEntry{timestamp: -1}) {
+		t.Errorf("remove() of a missing entry should return false")
+	}
+
+	var got []indexEntry
+	x.ascend(position{}, position{seg: len(x.segments)}, func(e indexEntry) bool {
+		got = append(got, e)
+		return true
+	})
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("entry %d = %v, want %v", i, got[i], want[i])
+		}
+	}
+
+	for _, ts := range []int64{-1, 0, 1234, 4999, 5000} {
+		var inRange []indexEntry
+		x.descend(x.lowerBound(ts, true), x.lowerBound(ts+100, false), func(e indexEntry) bool {
+			inRange = append(inRange, e)
+			return true
+		})
+
+		var expected []indexEntry
+		for i := len(want) - 1; i >= 0; i-- {
+			if want[i].timestamp >= ts && want[i].timestamp <= ts+100 {
+				expected = append(expected, want[i])
+			}
+		}
+		if len(inRange) != len(expected) {
+			t.Fatalf("range [%v, %v] has %v entries, want %v", ts, ts+100, len(inRange), len(expected))
+		}
+		for i := range expected {
+			if inRange[i] != expected[i] {
+				t.Fatalf("range [%v, %v] entry %d = %v, want %v", ts, ts+100, i, inRange[i], expected[i])
+			}
+		}
+	}
+}
+
+func TestGetRangeBounds(t *testing.T) {
+	c := NewTelematicsDataCache(10)
+	now := time.Now()
+	for i := 0; i < 5; i++ {
+		c.Add(models.TelematicsData{VehicleID: i, Timestamp: now.Add(time.Duration(i) * time.Second)})
+	}
+
+	from, to := now.Add(time.Second), now.Add(3*time.Second)
+	tests := []struct {
+		bounds RangeBounds
+		want   []int
+	}{
+		{ExcludeBoth, []int{2}},
+		{IncludeFrom, []int{2, 1}},
+		{IncludeTo, []int{3, 2}},
+		{IncludeBoth, []int{3, 2, 1}},
+	}
+	for _, tt := range tests {
+		result, err := c.GetRangeBounds(from, to, tt.bounds)
+		if err != nil {
+			t.Fatalf("GetRangeBounds(%v) error = %v", tt.bounds, err)
+		}
+		if len(result) != len(tt.want) {
+			t.Fatalf("GetRangeBounds(%v) = %v, want vehicles %v", tt.bounds, result, tt.want)
+		}
+		for i, id := range tt.want {
+			if result[i].VehicleID != id {
+				t.Errorf("GetRangeBounds(%v)[%d] = %v, want vehicle %v", tt.bounds, i, result[i], id)
+			}
+		}
+	}
+}
+
+func TestLatePointsAreOrderedByTime(t *testing.T) {
+	c := NewTelematicsDataCache(10)
+	now := time.Now()
+	for _, offset := range []int{10, 30, 20, 5, 25} {
+		c.Add(models.TelematicsData{Speed: offset, Timestamp: now.Add(time.Duration(offset) * time.Second)})
+	}
+
+	result, err := c.GetRangeBounds(now, now.Add(time.Minute), IncludeBoth)
+	if err != nil {
+		t.Fatalf("GetRangeBounds() error = %v", err)
+	}
+	want := []int{30, 25, 20, 10, 5}
+	for i, speed := range want {
+		if result[i].Speed != speed {
+			t.Errorf("GetRangeBounds()[%d] = %v, want the point at +%vs", i, result[i], speed)
+		}
+	}
+
+	if latest, _ := c.GetLatest(); latest.Speed != 25 {
+		t.Errorf("GetLatest() = %v, want the last added point", latest)
+	}
+}
+
+func fillCache(b *testing.B, size int) (*TelematicsDataCache, time.Time) {
+	c := NewTelematicsDataCache(size)
+	start := time.Now()
+	for i := 0; i < size; i++ {
+		c.Add(models.TelematicsData{
+			VehicleID: i % 100,
+			Timestamp: start.Add(time.Duration(i) * time.Millisecond),
+		})
+	}
+	b.ResetTimer()
+	return c, start
+}
+
+func BenchmarkAdd(b *testing.B) {
+	c, start := fillCache(b, 1000_000)
+	for i := 0; i < b.N; i++ {
+		c.Add(models.TelematicsData{Timestamp: start.Add(time.Duration(1000_000+i) * time.Millisecond)})
+	}
+}
+
+func BenchmarkAddOutOfOrder(b *testing.B) {
+	c, start := fillCache(b, 1000_000)
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < b.N; i++ {
+		c.Add(models.TelematicsData{Timestamp: start.Add(time.Duration(rnd.Intn(1000_000)) * time.Millisecond)})
+	}
+}
+
+func BenchmarkGetRange(b *testing.B) {
+	c, start := fillCache(b, 1000_000)
+	for i := 0; i < b.N; i++ {
+		from := start.Add(time.Duration(i%990_000) * time.Millisecond)
+		if _, err := c.GetRange(from, from.Add(time.Second)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+// BenchmarkGetRangeScan measures the full scan GetRange used to do, as
+// a reference for BenchmarkGetRange.
+func BenchmarkGetRangeScan(b *testing.B) {
+	c, start := fillCache(b, 1000_000)
+	for i := 0; i < b.N; i++ {
+		from := start.Add(time.Duration(i%990_000) * time.Millisecond)
+		to := from.Add(time.Second)
+
+		var result []models.TelematicsData
+		for _, item := range c.shards[0].items {
+			if item.Timestamp.After(from) && item.Timestamp.Before(to) {
+				result = append(result, item.Data)
+			}
+		}
+	}
+}
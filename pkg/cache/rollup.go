@@ -0,0 +1,182 @@
+package cache
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	geo "github.com/kellydunn/golang-geo"
+	"telematics-generator/pkg/models"
+)
+
+// Rollup tiers kept for every vehicle and how many of the latest buckets of
+// each tier are kept: a day of minutes and a month of hours.
+const (
+	MinuteResolution = time.Minute
+	HourResolution   = time.Hour
+
+	minuteBuckets = 24 * 60
+	hourBuckets   = 30 * 24
+)
+
+// Rollup aggregates the records of one vehicle within one time bucket.
+// Distance is in metres and counts the way from the previous record of the
+// vehicle; records arriving later than a newer record of the same vehicle
+// count towards speed only.
+type Rollup struct {
+	VehicleID  int
+	Start      time.Time
+	Resolution time.Duration
+	Count      int
+	Last       models.TelematicsData
+	MinSpeed   int
+	MaxSpeed   int
+	AvgSpeed   float64
+	Distance   float64
+	speedSum   int
+}
+
+type rollupTier struct {
+	resolution time.Duration
+	keep       int
+	buckets    map[int][]*Rollup
+}
+
+// rollups maintains the rollup tiers on every added record. Buckets of
+// a vehicle are kept sorted by start time and outlive the raw records.
+type rollups struct {
+	tiers []*rollupTier
+}
+
+func newRollups() *rollups {
+	return &rollups{tiers: []*rollupTier{
+		{resolution: MinuteResolution, keep: minuteBuckets, buckets: make(map[int][]*Rollup)},
+		{resolution: HourResolution, keep: hourBuckets, buckets: make(map[int][]*Rollup)},
+	}}
+}
+
+// add accounts the record in every tier. prev is the latest record of the
+// vehicle before this one, if any.
+func (r *rollups) add(telematicsData models.TelematicsData, prev models.TelematicsData, hasPrev bool) {
+	var distance float64
+	if hasPrev && !telematicsData.Timestamp.Before(prev.Timestamp) {
+		distance = geo.NewPoint(prev.Latitude, prev.Longitude).
+			GreatCircleDistance(geo.NewPoint(telematicsData.Latitude, telematicsData.Longitude)) * 1000
+	}
+
+	for _, tier := range r.tiers {
+		tier.add(telematicsData, distance)
+	}
+}
+
+func (t *rollupTier) add(telematicsData models.TelematicsData, distance float64) {
+	start := telematicsData.Timestamp.Truncate(t.resolution)
+	buckets := t.buckets[telematicsData.VehicleID]
+
+	i := sort.Search(len(buckets), func(i int) bool {
+		return !buckets[i].Start.Before(start)
+	})
+	if i == len(buckets) || !buckets[i].Start.Equal(start) {
+		if i == 0 && len(buckets) == t.keep {
+			return
+		}
+		buckets = append(buckets, nil)
+		copy(buckets[i+1:], buckets[i:])
+		buckets[i] = &Rollup{
+			VehicleID:  telematicsData.VehicleID,
+			Start:      start,
+			Resolution: t.resolution,
+			MinSpeed:   telematicsData.Speed,
+			MaxSpeed:   telematicsData.Speed,
+		}
+		if len(buckets) > t.keep {
+			buckets[0] = nil
+			buckets = buckets[1:]
+			i--
+		}
+		t.buckets[telematicsData.VehicleID] = buckets
+	}
+
+	b := buckets[i]
+	if b.Count == 0 || !telematicsData.Timestamp.Before(b.Last.Timestamp) {
+		b.Last = telematicsData
+	}
+	if telematicsData.Speed < b.MinSpeed {
+		b.MinSpeed = telematicsData.Speed
+	}
+	if telematicsData.Speed > b.MaxSpeed {
+		b.MaxSpeed = telematicsData.Speed
+	}
+	b.Count++
+	b.speedSum += telematicsData.Speed
+	b.AvgSpeed = float64(b.speedSum) / float64(b.Count)
+	b.Distance += distance
+}
+
+// dropBefore removes the buckets of the vehicle that end before t.
+func (r *rollups) dropBefore(vehicleID int, t time.Time) {
+	for _, tier := range r.tiers {
+		buckets := tier.buckets[vehicleID]
+		i := sort.Search(len(buckets), func(i int) bool {
+			return buckets[i].Start.Add(tier.resolution).After(t)
+		})
+		if i > 0 {
+			tier.buckets[vehicleID] = append([]*Rollup(nil), buckets[i:]...)
+		}
+	}
+}
+
+// forget removes every bucket of the vehicle.
+func (r *rollups) forget(vehicleID int) {
+	for _, tier := range r.tiers {
+		delete(tier.buckets, vehicleID)
+	}
+}
+
+// get returns copies of the buckets overlapping [from, to) of the given
+// vehicles, or of all vehicles if none are given, newest first.
+func (r *rollups) get(resolution time.Duration, from, to time.Time, vehicleIDs []int) ([]Rollup, error) {
+	if from.After(to) {
+		return nil, fmt.Errorf("the 'from' timestamp must be earlier than the 'to' timestamp")
+	}
+
+	var tier *rollupTier
+	for _, t := range r.tiers {
+		if t.resolution == resolution {
+			tier = t
+		}
+	}
+	if tier == nil {
+		return nil, fmt.Errorf("unsupported rollup resolution %v, supported are %v and %v", resolution, MinuteResolution, HourResolution)
+	}
+
+	if len(vehicleIDs) == 0 {
+		for vehicleID := range tier.buckets {
+			vehicleIDs = append(vehicleIDs, vehicleID)
+		}
+	}
+
+	var result []Rollup
+	for _, vehicleID := range vehicleIDs {
+		buckets := tier.buckets[vehicleID]
+		i := sort.Search(len(buckets), func(i int) bool {
+			return buckets[i].Start.Add(resolution).After(from)
+		})
+		for ; i < len(buckets) && buckets[i].Start.Before(to); i++ {
+			result = append(result, *buckets[i])
+		}
+	}
+	sortRollups(result)
+
+	return result, nil
+}
+
+// sortRollups sorts rollups newest first and then by vehicle.
+func sortRollups(rollups []Rollup) {
+	sort.Slice(rollups, func(i, j int) bool {
+		if !rollups[i].Start.Equal(rollups[j].Start) {
+			return rollups[i].Start.After(rollups[j].Start)
+		}
+		return rollups[i].VehicleID < rollups[j].VehicleID
+	})
+}
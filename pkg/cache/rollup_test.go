@@ -0,0 +1,76 @@
+package cache
+
+import (
+	"math"
+	"telematics-generator/pkg/models"
+	"testing"
+	"time"
+)
+
+func TestRollups(t *testing.T) {
+	c := NewTelematicsDataCache(2)
+	start := time.Date(2023, 7, 12, 10, 0, 0, 0, time.UTC)
+
+	points := []models.TelematicsData{
+		{VehicleID: 1, Timestamp: start.Add(10 * time.Second), Speed: 10, Latitude: 50, Longitude: 30},
+		{VehicleID: 1, Timestamp: start.Add(50 * time.Second), Speed: 30, Latitude: 50.001, Longitude: 30},
+		{VehicleID: 1, Timestamp: start.Add(70 * time.Second), Speed: 20, Latitude: 50.002, Longitude: 30},
+		{VehicleID: 1, Timestamp: start.Add(30 * time.Second), Speed: 50, Latitude: 51, Longitude: 31},
+		{VehicleID: 2, Timestamp: start.Add(20 * time.Second), Speed: 60, Latitude: 10, Longitude: 10},
+	}
+	for _, p := range points {
+		c.Add(p)
+	}
+
+	minutes, err := c.GetRollups(MinuteResolution, start, start.Add(time.Hour), 1)
+	if err != nil {
+		t.Fatalf("GetRollups() error = %v", err)
+	}
+	if len(minutes) != 2 {
+		t.Fatalf("GetRollups() = %v, want 2 minute buckets", minutes)
+	}
+
+	second, first := minutes[0], minutes[1]
+	if !first.Start.Equal(start) || first.Count != 3 || first.MinSpeed != 10 || first.MaxSpeed != 50 || first.AvgSpeed != 30 {
+		t.Errorf("first minute = %+v, want 3 records with speed 10/30/50", first)
+	}
+	if first.Last != points[1] {
+		t.Errorf("first minute last point = %v, want %v", first.Last, points[1])
+	}
+	if math.Abs(first.Distance-111.2) > 1 {
+		t.Errorf("first minute distance = %v, want about 111 m ignoring the late point", first.Distance)
+	}
+	if !second.Start.Equal(start.Add(time.Minute)) || second.Count != 1 || math.Abs(second.Distance-111.2) > 1 {
+		t.Errorf("second minute = %+v", second)
+	}
+
+	hours, err := c.GetRollups(HourResolution, start.Add(30*time.Minute), start.Add(31*time.Minute))
+	if err != nil {
+		t.Fatalf("GetRollups() error = %v", err)
+	}
+	if len(hours) != 2 || hours[0].VehicleID != 1 || hours[0].Count != 4 || hours[1].VehicleID != 2 {
+		t.Errorf("GetRollups() = %+v, want an hour bucket per vehicle", hours)
+	}
+
+	if _, err := c.GetRollups(time.Second, start, start.Add(time.Hour)); err == nil {
+		t.Errorf("GetRollups() with an unsupported resolution should return an error")
+	}
+}
+
+func TestRollupBucketsAreBounded(t *testing.T) {
+	r := newRollups()
+	start := time.Date(2023, 7, 12, 0, 0, 0, 0, time.UTC)
+
+	for i := 0; i < minuteBuckets+10; i++ {
+		r.add(models.TelematicsData{VehicleID: 1, Timestamp: start.Add(time.Duration(i) * time.Minute)}, models.TelematicsData{}, false)
+	}
+	r.add(models.TelematicsData{VehicleID: 1, Timestamp: start}, models.TelematicsData{}, false)
+
+	buckets := r.tiers[0].buckets[1]
+	if len(buckets) != minuteBuckets {
+		t.Fatalf("kept %v minute buckets, want %v", len(buckets), minuteBuckets)
+	}
+	if !buckets[0].Start.Equal(start.Add(10 * time.Minute)) {
+		t.Errorf("oldest kept bucket starts at %v, want %v", buckets[0].Start, start.Add(10*time.Minute))
+	}
+}
@@ -0,0 +1,72 @@
+package cache
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+
+	"telematics-generator/pkg/atomicfile"
+	"telematics-generator/pkg/models"
+)
+
+// Records returns all cached records in the order they were added, so adding
+// them to an empty cache restores its contents.
+func (c *TelematicsDataCache) Records() []models.TelematicsData {
+	var items []Item
+	for _, s := range c.shards {
+		s.mx.RLock()
+		for i := s.size; i >= 1; i-- {
+			if item := s.items[s.slot(s.next-uint64(i))]; !item.removed {
+				items = append(items, item)
+			}
+		}
+		s.mx.RUnlock()
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].order < items[j].order
+	})
+
+	records := make([]models.TelematicsData, 0, len(items))
+	for _, item := range items {
+		records = append(records, item.Data)
+	}
+	return records
+}
+
+// SaveSnapshot writes the records in the format of the disk storage segments
+// to a temporary file and renames it over path.
+func SaveSnapshot(path string, records []models.TelematicsData) error {
+	data := make([]byte, 0, len(records)*32)
+	for _, record := range records {
+		data = append(data, encodeRecord(record)...)
+	}
+
+	return atomicfile.Write(path, data)
+}
+
+// LoadSnapshot returns the records saved by SaveSnapshot. A missing file is
+// not an error, there is just nothing to restore.
+func LoadSnapshot(path string) ([]models.TelematicsData, error) {
+	var records []models.TelematicsData
+	size, err := readSegment(path, func(telematicsData models.TelematicsData) bool {
+		records = append(records, telematicsData)
+		return true
+	})
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if info.Size() != size {
+		return nil, fmt.Errorf("snapshot %s is corrupted at offset %d", path, size)
+	}
+
+	return records, nil
+}
@@ -0,0 +1,80 @@
+package cache
+
+import (
+	"os"
+	"path/filepath"
+	"telematics-generator/pkg/models"
+	"testing"
+	"time"
+)
+
+func TestSnapshotRestoresCache(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "snapshot.bin")
+	start := time.Date(2023, 7, 12, 10, 0, 0, 0, time.UTC)
+
+	c := NewTelematicsDataCache(5, WithShards(2))
+	for i := 0; i < 7; i++ {
+		c.Add(models.TelematicsData{
+			VehicleID: i % 3,
+			Timestamp: start.Add(time.Duration(7-i) * time.Second),
+			Speed:     i,
+			Latitude:  50 + float64(i)/100,
+			Longitude: 30,
+		})
+	}
+
+	if err := SaveSnapshot(path, c.Records()); err != nil {
+		t.Fatalf("SaveSnapshot() error = %v", err)
+	}
+	records, err := LoadSnapshot(path)
+	if err != nil {
+		t.Fatalf("LoadSnapshot() error = %v", err)
+	}
+
+	restored := NewTelematicsDataCache(5, WithShards(2))
+	for _, record := range records {
+		restored.Add(record)
+	}
+
+	want, _ := c.GetRange(start, start.Add(time.Minute))
+	got, err := restored.GetRange(start, start.Add(time.Minute))
+	if err != nil {
+		t.Fatalf("GetRange() error = %v", err)
+	}
+	if len(got) != len(want) || len(got) != c.Stats().Len {
+		t.Fatalf("restored GetRange() = %v, want %v", got, want)
+	}
+	for i := range want {
+		if !got[i].Timestamp.Equal(want[i].Timestamp) {
+			t.Errorf("restored GetRange()[%d] timestamp = %v, want %v", i, got[i].Timestamp, want[i].Timestamp)
+		}
+		got[i].Timestamp = want[i].Timestamp
+		if got[i] != want[i] {
+			t.Errorf("restored GetRange()[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+	if latest, _ := restored.GetLatest(); latest.Speed != 6 {
+		t.Errorf("restored GetLatest() = %v, want the last added record", latest)
+	}
+}
+
+func TestLoadSnapshot(t *testing.T) {
+	dir := t.TempDir()
+
+	records, err := LoadSnapshot(filepath.Join(dir, "missing.bin"))
+	if err != nil || records != nil {
+		t.Errorf("LoadSnapshot() of a missing file = %v, %v, want nothing", records, err)
+	}
+
+	path := filepath.Join(dir, "snapshot.bin")
+	if err := SaveSnapshot(path, []models.TelematicsData{{VehicleID: 1, Timestamp: time.Now()}}); err != nil {
+		t.Fatalf("SaveSnapshot() error = %v", err)
+	}
+	data, _ := os.ReadFile(path)
+	if err := os.WriteFile(path, data[:len(data)-1], 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadSnapshot(path); err == nil {
+		t.Errorf("LoadSnapshot() of a truncated file should return an error")
+	}
+}
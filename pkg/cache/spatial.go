@@ -0,0 +1,314 @@
+package cache
+
+import (
+	"errors"
+	"math"
+
+	geo "github.com/kellydunn/golang-geo"
+	"telematics-generator/pkg/models"
+)
+
+const (
+	// Cells are geohash cells of 25 bits, i.e. 5 geohash characters, which
+	// is about 4.9 km by 4.9 km at the equator.
+	cellLatBits = 12
+	cellLngBits = 13
+	cellLatRows = 1 << cellLatBits
+	cellLngCols = 1 << cellLngBits
+)
+
+type Point struct {
+	Latitude  float64
+	Longitude float64
+}
+
+// Area is a region of the Earth surface records can be searched in.
+type Area interface {
+	Bounds() BoundingBox
+	Contains(Point) bool
+}
+
+// BoundingBox is a latitude/longitude rectangle. If MinLongitude is greater
+// than MaxLongitude, the box crosses the antimeridian.
+type BoundingBox struct {
+	MinLatitude  float64
+	MinLongitude float64
+	MaxLatitude  float64
+	MaxLongitude float64
+}
+
+// Polygon is a simple polygon given by its vertices in order. Its edges are
+// straight lines in latitude/longitude, and it must not cross the antimeridian.
+type Polygon []Point
+
+// Circle is the set of points within Radius metres of Center along the
+// surface of the Earth.
+type Circle struct {
+	Center Point
+	Radius float64
+}
+
+func (b BoundingBox) Validate() error {
+	if !validPoint(Point{b.MinLatitude, b.MinLongitude}) || !validPoint(Point{b.MaxLatitude, b.MaxLongitude}) {
+		return errors.New("bounding box corners must be valid coordinates")
+	}
+	if b.MinLatitude > b.MaxLatitude {
+		return errors.New("bounding box min latitude must not be greater than max latitude")
+	}
+	return nil
+}
+
+func (b BoundingBox) Bounds() BoundingBox {
+	return b
+}
+
+func (b BoundingBox) Contains(p Point) bool {
+	if p.Latitude < b.MinLatitude || p.Latitude > b.MaxLatitude {
+		return false
+	}
+	if b.MinLongitude <= b.MaxLongitude {
+		return p.Longitude >= b.MinLongitude && p.Longitude <= b.MaxLongitude
+	}
+	return p.Longitude >= b.MinLongitude || p.Longitude <= b.MaxLongitude
+}
+
+func (b BoundingBox) Intersects(other BoundingBox) bool {
+	if b.MaxLatitude < other.MinLatitude || other.MaxLatitude < b.MinLatitude {
+		return false
+	}
+	for _, x := range b.longitudeSpans() {
+		for _, y := range other.longitudeSpans() {
+			if x[0] <= y[1] && y[0] <= x[1] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (b BoundingBox) longitudeSpans() [][2]float64 {
+	if b.MinLongitude <= b.MaxLongitude {
+		return [][2]float64{{b.MinLongitude, b.MaxLongitude}}
+	}
+	return [][2]float64{{b.MinLongitude, 180}, {-180, b.MaxLongitude}}
+}
+
+func (p Polygon) Validate() error {
+	if len(p) < 3 {
+		return errors.New("polygon must have at least 3 points")
+	}
+	for _, point := range p {
+		if !validPoint(point) {
+			return errors.New("polygon points must be valid coordinates")
+		}
+	}
+	return nil
+}
+
+func (p Polygon) Bounds() BoundingBox {
+	b := BoundingBox{MinLatitude: 90, MinLongitude: 180, MaxLatitude: -90, MaxLongitude: -180}
+	for _, point := range p {
+		b.MinLatitude = math.Min(b.MinLatitude, point.Latitude)
+		b.MaxLatitude = math.Max(b.MaxLatitude, point.Latitude)
+		b.MinLongitude = math.Min(b.MinLongitude, point.Longitude)
+		b.MaxLongitude = math.Max(b.MaxLongitude, point.Longitude)
+	}
+	return b
+}
+
+// Contains uses the even-odd rule, points on the boundary may fall either way.
+func (p Polygon) Contains(point Point) bool {
+	inside := false
+	for i, j := 0, len(p)-1; i < len(p); j, i = i, i+1 {
+		a, b := p[i], p[j]
+		if (a.Latitude > point.Latitude) != (b.Latitude > point.Latitude) &&
+			point.Longitude < (b.Longitude-a.Longitude)*(point.Latitude-a.Latitude)/(b.Latitude-a.Latitude)+a.Longitude {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+func (c Circle) Validate() error {
+	if !validPoint(c.Center) {
+		return errors.New("circle center must be valid coordinates")
+	}
+	if c.Radius <= 0 {
+		return errors.New("circle radius must be positive")
+	}
+	return nil
+}
+
+func (c Circle) Bounds() BoundingBox {
+	delta := c.Radius / models.EarthRadius * 180 / math.Pi
+	b := BoundingBox{
+		MinLatitude:  c.Center.Latitude - delta,
+		MaxLatitude:  c.Center.Latitude + delta,
+		MinLongitude: -180,
+		MaxLongitude: 180,
+	}
+	if b.MinLatitude <= -90 || b.MaxLatitude >= 90 {
+		b.MinLatitude = math.Max(b.MinLatitude, -90)
+		b.MaxLatitude = math.Min(b.MaxLatitude, 90)
+		return b
+	}
+
+	lngDelta := delta / math.Cos(c.Center.Latitude*math.Pi/180)
+	if lngDelta >= 180 {
+		return b
+	}
+	b.MinLongitude = normalizeLongitude(c.Center.Longitude - lngDelta)
+	b.MaxLongitude = normalizeLongitude(c.Center.Longitude + lngDelta)
+	return b
+}
+
+func (c Circle) Contains(p Point) bool {
+	center := geo.NewPoint(c.Center.Latitude, c.Center.Longitude)
+	return center.GreatCircleDistance(geo.NewPoint(p.Latitude, p.Longitude))*1000 <= c.Radius
+}
+
+func validPoint(p Point) bool {
+	return p.Latitude >= -90 && p.Latitude <= 90 && p.Longitude >= -180 && p.Longitude <= 180
+}
+
+func normalizeLongitude(lng float64) float64 {
+	for lng > 180 {
+		lng -= 360
+	}
+	for lng < -180 {
+		lng += 360
+	}
+	return lng
+}
+
+// cell is a geohash cell: the bits of its column and row interleaved,
+// starting with the longitude bit.
+type cell uint64
+
+func cellRow(lat float64) int {
+	row := int((lat + 90) / 180 * cellLatRows)
+	if row >= cellLatRows {
+		row = cellLatRows - 1
+	}
+	if row < 0 {
+		row = 0
+	}
+	return row
+}
+
+func cellCol(lng float64) int {
+	col := int((lng + 180) / 360 * cellLngCols)
+	if col >= cellLngCols {
+		col = cellLngCols - 1
+	}
+	if col < 0 {
+		col = 0
+	}
+	return col
+}
+
+func cellAt(p Point) cell {
+	return makeCell(cellRow(p.Latitude), cellCol(p.Longitude))
+}
+
+func makeCell(row, col int) cell {
+	var c cell
+	for i := cellLngBits - 1; i >= 0; i-- {
+		c = c<<1 | cell(col>>i&1)
+		if i < cellLatBits {
+			c = c<<1 | cell(row>>i&1)
+		}
+	}
+	return c
+}
+
+func (c cell) rowCol() (int, int) {
+	var row, col int
+	shift := cellLatBits + cellLngBits
+	for i := cellLngBits - 1; i >= 0; i-- {
+		shift--
+		col |= int(c>>shift&1) << i
+		if i < cellLatBits {
+			shift--
+			row |= int(c>>shift&1) << i
+		}
+	}
+	return row, col
+}
+
+func (c cell) bounds() BoundingBox {
+	row, col := c.rowCol()
+	return BoundingBox{
+		MinLatitude:  float64(row)*180/cellLatRows - 90,
+		MaxLatitude:  float64(row+1)*180/cellLatRows - 90,
+		MinLongitude: float64(col)*360/cellLngCols - 180,
+		MaxLongitude: float64(col+1)*360/cellLngCols - 180,
+	}
+}
+
+// spatialIndex keeps a time index of the records in every non-empty cell.
+type spatialIndex struct {
+	cells map[cell]*timeIndex
+}
+
+func newSpatialIndex() spatialIndex {
+	return spatialIndex{cells: make(map[cell]*timeIndex)}
+}
+
+func (s *spatialIndex) insert(p Point, e indexEntry) {
+	c := cellAt(p)
+	index, ok := s.cells[c]
+	if !ok {
+		index = &timeIndex{}
+		s.cells[c] = index
+	}
+	index.insert(e)
+}
+
+func (s *spatialIndex) remove(p Point, e indexEntry) {
+	c := cellAt(p)
+	index, ok := s.cells[c]
+	if !ok {
+		return
+	}
+	index.remove(e)
+	if index.len() == 0 {
+		delete(s.cells, c)
+	}
+}
+
+// covering returns the time indexes of the non-empty cells overlapping the
+// bounding box. It walks the cells of the box or all non-empty cells,
+// whichever is fewer.
+func (s *spatialIndex) covering(b BoundingBox) []*timeIndex {
+	minRow, maxRow := cellRow(b.MinLatitude), cellRow(b.MaxLatitude)
+
+	var colSpans [][2]int
+	total := 0
+	for _, span := range b.longitudeSpans() {
+		minCol, maxCol := cellCol(span[0]), cellCol(span[1])
+		colSpans = append(colSpans, [2]int{minCol, maxCol})
+		total += (maxCol - minCol + 1) * (maxRow - minRow + 1)
+	}
+
+	var result []*timeIndex
+	if total > len(s.cells) {
+		for c, index := range s.cells {
+			if c.bounds().Intersects(b) {
+				result = append(result, index)
+			}
+		}
+		return result
+	}
+
+	for _, span := range colSpans {
+		for row := minRow; row <= maxRow; row++ {
+			for col := span[0]; col <= span[1]; col++ {
+				if index, ok := s.cells[makeCell(row, col)]; ok {
+					result = append(result, index)
+				}
+			}
+		}
+	}
+	return result
+}
@@ -0,0 +1,100 @@
+package cache
+
+import (
+	"math/rand"
+	"telematics-generator/pkg/models"
+	"testing"
+	"time"
+)
+
+func TestCellRowCol(t *testing.T) {
+	for _, rc := range [][2]int{{0, 0}, {cellLatRows - 1, cellLngCols - 1}, {1234, 5678}, {4000, 1}} {
+		row, col := makeCell(rc[0], rc[1]).rowCol()
+		if row != rc[0] || col != rc[1] {
+			t.Errorf("makeCell(%v, %v).rowCol() = %v, %v", rc[0], rc[1], row, col)
+		}
+	}
+
+	p := Point{Latitude: 55.7558, Longitude: 37.6173}
+	if !cellAt(p).bounds().Contains(p) {
+		t.Errorf("cell %v does not contain its point %v", cellAt(p).bounds(), p)
+	}
+}
+
+func TestAreaContains(t *testing.T) {
+	box := BoundingBox{MinLatitude: -10, MinLongitude: 170, MaxLatitude: 10, MaxLongitude: -170}
+	if !box.Contains(Point{0, 175}) || !box.Contains(Point{0, -175}) || box.Contains(Point{0, 0}) {
+		t.Errorf("antimeridian bounding box %v contains wrong points", box)
+	}
+
+	square := Polygon{{0, 0}, {0, 10}, {10, 10}, {10, 0}}
+	if !square.Contains(Point{5, 5}) || square.Contains(Point{15, 5}) {
+		t.Errorf("polygon %v contains wrong points", square)
+	}
+
+	circle := Circle{Center: Point{55.7558, 37.6173}, Radius: 1000}
+	if !circle.Contains(Point{55.7600, 37.6173}) || circle.Contains(Point{55.7700, 37.6173}) {
+		t.Errorf("circle %v contains wrong points", circle)
+	}
+	if b := circle.Bounds(); !b.Contains(Point{55.7640, 37.6173}) || !b.Contains(Point{55.7558, 37.6300}) {
+		t.Errorf("circle bounds %v do not cover the circle", b)
+	}
+}
+
+func TestGetInArea(t *testing.T) {
+	c := NewTelematicsDataCache(5000)
+	rnd := rand.New(rand.NewSource(1))
+	start := time.Now()
+
+	var added []models.TelematicsData
+	for i := 0; i < 6000; i++ {
+		data := models.TelematicsData{
+			VehicleID: i % 20,
+			Timestamp: start.Add(time.Duration(rnd.Intn(3600)) * time.Second),
+			Latitude:  rnd.Float64()*20 + 40,
+			Longitude: rnd.Float64()*20 + 170,
+		}
+		if data.Longitude > 180 {
+			data.Longitude -= 360
+		}
+		added = append(added, data)
+		c.Add(data)
+	}
+	retained := added[1000:]
+
+	areas := []Area{
+		BoundingBox{MinLatitude: 45, MinLongitude: 175, MaxLatitude: 50, MaxLongitude: -175},
+		Polygon{{42, 171}, {58, 172}, {50, 179}},
+		Circle{Center: Point{50, 180}, Radius: 200000},
+	}
+	from, to := start.Add(10*time.Minute), start.Add(40*time.Minute)
+	for _, area := range areas {
+		result, err := c.GetInArea(area, from, to, IncludeBoth)
+		if err != nil {
+			t.Fatalf("GetInArea(%v) error = %v", area, err)
+		}
+
+		want := 0
+		for _, data := range retained {
+			if area.Contains(point(data)) && inRange(data.Timestamp, from, to, IncludeBoth) {
+				want++
+			}
+		}
+		if want == 0 || len(result) != want {
+			t.Errorf("GetInArea(%v) returned %v records, want %v", area, len(result), want)
+		}
+		for i := 1; i < len(result); i++ {
+			if result[i].Timestamp.After(result[i-1].Timestamp) {
+				t.Fatalf("GetInArea(%v) is not ordered newest first", area)
+			}
+		}
+	}
+
+	cells := 0
+	for _, index := range c.shards[0].spatial.cells {
+		cells += index.len()
+	}
+	if cells != c.Stats().Len {
+		t.Errorf("spatial index has %v entries, want %v", cells, c.Stats().Len)
+	}
+}
@@ -0,0 +1,184 @@
+package clock
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// Clock is the simulation clock. It starts at the wall clock time and runs
+// factor times faster than the wall clock. It can be paused, stepped by
+// ticks and moved forward.
+type Clock struct {
+	mx       sync.Mutex
+	tick     time.Duration
+	factor   float64
+	paused   bool
+	base     time.Time
+	wallBase time.Time
+	// changed is closed and replaced whenever the clock is changed.
+	changed chan struct{}
+}
+
+// State is a snapshot of the clock.
+type State struct {
+	Now    time.Time
+	Factor float64
+	Paused bool
+	Tick   time.Duration
+}
+
+func New(tick time.Duration, factor float64) (*Clock, error) {
+	if tick <= 0 {
+		return nil, errors.New("tick should be positive")
+	}
+	if factor <= 0 {
+		return nil, errors.New("factor should be positive")
+	}
+
+	now := time.Now()
+	return &Clock{
+		tick:     tick,
+		factor:   factor,
+		base:     now,
+		wallBase: now,
+		changed:  make(chan struct{}),
+	}, nil
+}
+
+// Now returns the current simulated time.
+func (c *Clock) Now() time.Time {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	return c.now()
+}
+
+func (c *Clock) State() State {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	return c.state()
+}
+
+// Pause stops the clock until Resume. Step and Jump still move a paused
+// clock.
+func (c *Clock) Pause() State {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	c.rebase(0)
+	c.paused = true
+	c.notify()
+	return c.state()
+}
+
+func (c *Clock) Resume() State {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	c.rebase(0)
+	c.paused = false
+	c.notify()
+	return c.state()
+}
+
+// SetFactor sets how many times faster than the wall clock the clock runs.
+func (c *Clock) SetFactor(factor float64) (State, error) {
+	if factor <= 0 {
+		return State{}, errors.New("factor should be positive")
+	}
+
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	c.rebase(0)
+	c.factor = factor
+	c.notify()
+	return c.state(), nil
+}
+
+// Step moves the clock forward by ticks ticks.
+func (c *Clock) Step(ticks int) (State, error) {
+	if ticks <= 0 {
+		return State{}, errors.New("ticks should be positive")
+	}
+
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	c.rebase(time.Duration(ticks) * c.tick)
+	c.notify()
+	return c.state(), nil
+}
+
+// Jump moves the clock forward by d. Sleepers whose time has come wake up
+// at once, so that every vehicle catches up with the new time.
+func (c *Clock) Jump(d time.Duration) (State, error) {
+	if d <= 0 {
+		return State{}, errors.New("jump should be positive")
+	}
+
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	c.rebase(d)
+	c.notify()
+	return c.state(), nil
+}
+
+// SleepUntil blocks until the simulated time reaches t and returns true, or
+// returns false once stop is closed.
+func (c *Clock) SleepUntil(t time.Time, stop <-chan struct{}) bool {
+	for {
+		c.mx.Lock()
+		now := c.now()
+		paused, factor, changed := c.paused, c.factor, c.changed
+		c.mx.Unlock()
+
+		if !now.Before(t) {
+			return true
+		}
+
+		if paused {
+			select {
+			case <-stop:
+				return false
+			case <-changed:
+			}
+			continue
+		}
+
+		timer := time.NewTimer(time.Duration(float64(t.Sub(now)) / factor))
+		select {
+		case <-stop:
+			timer.Stop()
+			return false
+		case <-changed:
+			timer.Stop()
+		case <-timer.C:
+		}
+	}
+}
+
+func (c *Clock) now() time.Time {
+	if c.paused {
+		return c.base
+	}
+	return c.base.Add(time.Duration(float64(time.Since(c.wallBase)) * c.factor))
+}
+
+// rebase restarts counting from the current time moved forward by d.
+func (c *Clock) rebase(d time.Duration) {
+	c.base = c.now().Add(d)
+	c.wallBase = time.Now()
+}
+
+func (c *Clock) notify() {
+	close(c.changed)
+	c.changed = make(chan struct{})
+}
+
+func (c *Clock) state() State {
+	return State{Now: c.now(), Factor: c.factor, Paused: c.paused, Tick: c.tick}
+}
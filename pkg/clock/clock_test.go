@@ -0,0 +1,88 @@
+package clock
+
+import (
+	"testing"
+	"time"
+)
+
+func TestClock(t *testing.T) {
+	if _, err := New(0, 1); err == nil {
+		t.Errorf("New() with zero tick error = nil")
+	}
+	c, err := New(time.Second, 1)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	paused := c.Pause()
+	time.Sleep(10 * time.Millisecond)
+	if now := c.Now(); !now.Equal(paused.Now) {
+		t.Errorf("Now() of a paused clock moved from %v to %v", paused.Now, now)
+	}
+
+	state, err := c.Step(3)
+	if err != nil {
+		t.Fatalf("Step() error = %v", err)
+	}
+	if got := state.Now.Sub(paused.Now); got != 3*time.Second || !state.Paused {
+		t.Errorf("Step(3) moved a paused clock by %v, want 3s", got)
+	}
+
+	state, err = c.Jump(time.Hour)
+	if err != nil {
+		t.Fatalf("Jump() error = %v", err)
+	}
+	if got := state.Now.Sub(paused.Now); got != time.Hour+3*time.Second {
+		t.Errorf("Jump(1h) after Step(3) moved the clock by %v", got)
+	}
+	if _, err := c.Jump(-time.Second); err == nil {
+		t.Errorf("Jump() backwards error = nil")
+	}
+
+	if _, err := c.SetFactor(0); err == nil {
+		t.Errorf("SetFactor(0) error = nil")
+	}
+	if _, err := c.SetFactor(1000); err != nil {
+		t.Fatalf("SetFactor() error = %v", err)
+	}
+	resumed := c.Resume()
+	time.Sleep(20 * time.Millisecond)
+	if got := c.Now().Sub(resumed.Now); got < 20*time.Second {
+		t.Errorf("clock running 1000 times faster moved by %v in 20ms", got)
+	}
+}
+
+func TestSleepUntil(t *testing.T) {
+	c, _ := New(time.Second, 1)
+	start := c.Pause().Now
+
+	woken := make(chan bool)
+	go func() {
+		woken <- c.SleepUntil(start.Add(5*time.Second), nil)
+	}()
+
+	c.Step(4)
+	select {
+	case <-woken:
+		t.Fatalf("SleepUntil() returned 1s before its time")
+	case <-time.After(20 * time.Millisecond):
+	}
+	c.Step(1)
+	select {
+	case ok := <-woken:
+		if !ok {
+			t.Errorf("SleepUntil() = false, want true")
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("SleepUntil() did not return after the clock reached its time")
+	}
+
+	stop := make(chan struct{})
+	go func() {
+		woken <- c.SleepUntil(start.Add(time.Hour), stop)
+	}()
+	close(stop)
+	if ok := <-woken; ok {
+		t.Errorf("SleepUntil() after stop = true, want false")
+	}
+}
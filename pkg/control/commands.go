@@ -0,0 +1,175 @@
+package control
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"telematics-generator/pkg/models"
+)
+
+// Outputs is the number of outputs of a vehicle device.
+const Outputs = 8
+
+// commandQueue limits the commands waiting to be applied to one vehicle.
+const commandQueue = 64
+
+var ErrCommandQueueFull = errors.New("command queue is full")
+
+type CommandType int
+
+const (
+	SetInterval CommandType = iota
+	Immobilise
+	RequestPosition
+	SetOutput
+)
+
+// Command is a command sent to the device of a vehicle. Interval is the
+// reporting interval of SetInterval, zero restores the random time step.
+// Immobilise false releases an immobilised vehicle. Output and OutputOn are
+// the output switched by SetOutput.
+type Command struct {
+	ID         string
+	VehicleID  int
+	Type       CommandType
+	Interval   time.Duration
+	Immobilise bool
+	Output     int
+	OutputOn   bool
+	QueuedAt   time.Time
+	ApplyAt    time.Time
+}
+
+func (cmd Command) Validate() error {
+	switch cmd.Type {
+	case SetInterval:
+		if cmd.Interval < 0 || cmd.Interval > 24*time.Hour {
+			return errors.New("interval should be from 0 to 24h")
+		}
+		if cmd.Interval > 0 && cmd.Interval < time.Second {
+			return errors.New("interval should be at least 1s")
+		}
+	case Immobilise, RequestPosition:
+	case SetOutput:
+		if cmd.Output < 0 || cmd.Output >= Outputs {
+			return fmt.Errorf("output should be from 0 to %d", Outputs-1)
+		}
+	default:
+		return fmt.Errorf("unknown command type %d", cmd.Type)
+	}
+	return nil
+}
+
+// Ack acknowledges a command. A command fails when its vehicle is removed
+// before the command is applied, RequestPosition also fails when the vehicle
+// has no position yet. Position is the record reported for
+// RequestPosition.
+type Ack struct {
+	Command   Command
+	Applied   bool
+	AppliedAt time.Time
+	Error     string
+	Position  models.TelematicsData
+}
+
+// AckSink receives the acknowledgement of every command.
+type AckSink func(Ack)
+
+// SendCommand queues the command for its vehicle and returns it with its ID
+// and times set. The command is applied once the simulation clock reaches
+// ApplyAt, commands of a vehicle are applied in the order they are sent.
+func (c *Controller) SendCommand(cmd Command) (Command, error) {
+	if err := cmd.Validate(); err != nil {
+		return Command{}, err
+	}
+
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	r, ok := c.vehicles[cmd.VehicleID]
+	if !ok || r.stopping {
+		return Command{}, fmt.Errorf("%w: %d", ErrUnknownVehicle, cmd.VehicleID)
+	}
+
+	if cmd.ID == "" {
+		c.commandSeq++
+		cmd.ID = strconv.FormatUint(c.commandSeq, 10)
+	}
+	cmd.QueuedAt = c.gen.Clock().Now()
+	cmd.ApplyAt = cmd.QueuedAt.Add(c.latency)
+
+	select {
+	case r.commands <- cmd:
+		return cmd, nil
+	default:
+		return Command{}, fmt.Errorf("%w: %d commands wait for vehicle %d", ErrCommandQueueFull, commandQueue, cmd.VehicleID)
+	}
+}
+
+// runCommands applies the commands of the vehicle until it is removed and
+// fails the ones left.
+func (c *Controller) runCommands(r *runner) {
+	for {
+		select {
+		case <-r.stop:
+			c.failCommands(r)
+			return
+		case cmd := <-r.commands:
+			if !c.gen.Clock().SleepUntil(cmd.ApplyAt, r.stop) {
+				c.fail(cmd)
+				c.failCommands(r)
+				return
+			}
+			c.acks(c.apply(cmd))
+		}
+	}
+}
+
+func (c *Controller) apply(cmd Command) Ack {
+	ack := Ack{Command: cmd, Applied: true, AppliedAt: c.gen.Clock().Now()}
+	switch cmd.Type {
+	case SetInterval:
+		c.gen.SetInterval(cmd.VehicleID, cmd.Interval)
+	case Immobilise:
+		c.gen.SetImmobilised(cmd.VehicleID, cmd.Immobilise)
+	case RequestPosition:
+		state, ok := c.gen.State(cmd.VehicleID)
+		if !ok {
+			ack.Applied = false
+			ack.Error = "no position yet"
+			break
+		}
+		speed := state.Speed
+		if c.gen.Settings(cmd.VehicleID).Immobilised {
+			speed = 0
+		}
+		ack.Position = models.TelematicsData{
+			VehicleID: cmd.VehicleID,
+			Timestamp: ack.AppliedAt,
+			Speed:     speed,
+			Latitude:  state.Latitude,
+			Longitude: state.Longitude,
+		}
+		c.record(ack.Position)
+	case SetOutput:
+		c.gen.SetOutput(cmd.VehicleID, cmd.Output, cmd.OutputOn)
+	}
+	return ack
+}
+
+func (c *Controller) failCommands(r *runner) {
+	for {
+		select {
+		case cmd := <-r.commands:
+			c.fail(cmd)
+		default:
+			return
+		}
+	}
+}
+
+func (c *Controller) fail(cmd Command) {
+	c.acks(Ack{Command: cmd, AppliedAt: c.gen.Clock().Now(), Error: "vehicle was removed"})
+}
@@ -0,0 +1,137 @@
+package control
+
+import (
+	"errors"
+	"sync"
+	"telematics-generator/pkg/clock"
+	"telematics-generator/pkg/generator"
+	"telematics-generator/pkg/models"
+	"testing"
+	"time"
+)
+
+func TestCommands(t *testing.T) {
+	simClock, _ := clock.New(time.Second, 1)
+	start := simClock.Pause().Now
+	gen := generator.NewRandomTelematicsGenerator(100, 10, generator.WithClock(simClock))
+
+	var mx sync.Mutex
+	var records []models.TelematicsData
+	acks := make(chan Ack, 10)
+	c := New(gen, func(d models.TelematicsData) {
+		mx.Lock()
+		defer mx.Unlock()
+		records = append(records, d)
+	}, 2, WithCommandLatency(5*time.Second), WithAckSink(func(ack Ack) { acks <- ack }))
+
+	if _, err := c.SendCommand(Command{VehicleID: 1, Type: Immobilise, Immobilise: true}); !errors.Is(err, ErrUnknownVehicle) {
+		t.Errorf("SendCommand() to an unknown vehicle error = %v, want ErrUnknownVehicle", err)
+	}
+	if _, err := c.SendCommand(Command{VehicleID: 1, Type: SetOutput, Output: Outputs}); err == nil {
+		t.Errorf("SendCommand() to a missing output error = nil")
+	}
+
+	if _, err := c.Add(1, 0, 0); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+	immobilise, err := c.SendCommand(Command{VehicleID: 1, Type: Immobilise, Immobilise: true})
+	if err != nil {
+		t.Fatalf("SendCommand() error = %v", err)
+	}
+	if immobilise.ID == "" || !immobilise.QueuedAt.Equal(start) || immobilise.ApplyAt.Sub(start) != 5*time.Second {
+		t.Errorf("SendCommand() = %+v, want an ID and the command applied 5s after %v", immobilise, start)
+	}
+	if _, err := c.SendCommand(Command{ID: "position", VehicleID: 1, Type: RequestPosition}); err != nil {
+		t.Fatalf("SendCommand() error = %v", err)
+	}
+	if _, err := c.SendCommand(Command{VehicleID: 1, Type: SetOutput, Output: 3, OutputOn: true}); err != nil {
+		t.Fatalf("SendCommand() error = %v", err)
+	}
+
+	select {
+	case ack := <-acks:
+		t.Fatalf("got %+v before the latency passed", ack)
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	simClock.Step(5)
+	var got []Ack
+	for len(got) < 3 {
+		select {
+		case ack := <-acks:
+			got = append(got, ack)
+		case <-time.After(time.Second):
+			t.Fatalf("got acks %+v, want 3", got)
+		}
+	}
+	if got[0].Command.ID != immobilise.ID || got[1].Command.ID != "position" || got[2].Command.Type != SetOutput {
+		t.Errorf("acks = %+v, want them in the order the commands were sent", got)
+	}
+	for _, ack := range got {
+		if !ack.Applied || ack.AppliedAt.Before(ack.Command.ApplyAt) {
+			t.Errorf("ack = %+v, want a command applied not before %v", ack, ack.Command.ApplyAt)
+		}
+	}
+	if p := got[1].Position; p.VehicleID != 1 || !p.Timestamp.Equal(got[1].AppliedAt) {
+		t.Errorf("position = %+v, want one of vehicle 1 at %v", p, got[1].AppliedAt)
+	}
+	settings := gen.Settings(1)
+	if !settings.Immobilised || settings.Outputs != 1<<3 {
+		t.Errorf("Settings() = %+v, want an immobilised vehicle with output 3 on", settings)
+	}
+
+	// Records after the command stand still where the vehicle was.
+	simClock.Jump(time.Minute)
+	time.Sleep(50 * time.Millisecond)
+	mx.Lock()
+	var last models.TelematicsData
+	var still []models.TelematicsData
+	for _, d := range records {
+		if d.Timestamp.After(immobilise.ApplyAt) {
+			still = append(still, d)
+		} else if d.Timestamp.After(last.Timestamp) {
+			last = d
+		}
+	}
+	mx.Unlock()
+	if len(still) < 2 {
+		t.Fatalf("got %v records after the command", len(still))
+	}
+	for _, d := range still {
+		if d.Speed != 0 || d.Latitude != last.Latitude || d.Longitude != last.Longitude {
+			t.Errorf("immobilised vehicle reported %+v after %+v", d, last)
+		}
+	}
+
+	if _, err := c.SendCommand(Command{VehicleID: 1, Type: SetInterval, Interval: time.Minute}); err != nil {
+		t.Fatalf("SendCommand() error = %v", err)
+	}
+	if err := c.Remove(1); err != nil {
+		t.Fatalf("Remove() error = %v", err)
+	}
+	select {
+	case ack := <-acks:
+		if ack.Applied || ack.Error == "" {
+			t.Errorf("ack of a command to a removed vehicle = %+v, want a failure", ack)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("no ack of a command to a removed vehicle")
+	}
+}
+
+func TestRequestPositionWithoutState(t *testing.T) {
+	simClock, _ := clock.New(time.Second, 1)
+	gen := generator.NewRandomTelematicsGenerator(100, 10, generator.WithClock(simClock))
+	var records []models.TelematicsData
+	c := New(gen, func(d models.TelematicsData) {
+		records = append(records, d)
+	}, 1)
+
+	ack := c.apply(Command{VehicleID: 1, Type: RequestPosition})
+	if ack.Applied || ack.Error == "" {
+		t.Errorf("apply() = %+v, want a failure for a vehicle without a position", ack)
+	}
+	if len(records) != 0 {
+		t.Errorf("got records %+v, want none", records)
+	}
+}
@@ -0,0 +1,359 @@
+package control
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"telematics-generator/pkg/clock"
+	"telematics-generator/pkg/generator"
+	"telematics-generator/pkg/models"
+)
+
+var (
+	ErrVehicleExists   = errors.New("vehicle is already running")
+	ErrUnknownVehicle  = errors.New("vehicle is not running")
+	ErrTooManyVehicles = errors.New("too many vehicles")
+	ErrStopped         = errors.New("controller is stopped")
+)
+
+// staleSteps is the number of time steps of the slowest vehicle Err waits for
+// a record. Steps shorter than minStaleStep, e.g. a max time step under 1s
+// truncated to 0, count as minStaleStep.
+const (
+	staleSteps   = 3
+	minStaleStep = time.Second
+)
+
+// Sink receives every generated record.
+type Sink func(models.TelematicsData)
+
+// Controller runs a generator goroutine per vehicle and lets vehicles be added,
+// removed, paused and retuned while the simulation runs.
+type Controller struct {
+	gen         *generator.RandomTelematicsGenerator
+	sink        Sink
+	maxVehicles int
+	latency     time.Duration
+	acks        AckSink
+	mx          sync.Mutex
+	vehicles    map[int]*runner
+	commandSeq  uint64
+	stopped     bool
+	// since is when the wait for a record last started, lastRecord when the
+	// last record reached the sink, both by the simulation clock.
+	since      time.Time
+	lastRecord atomic.Int64
+	wg         sync.WaitGroup
+}
+
+type runner struct {
+	stop     chan struct{}
+	done     chan struct{}
+	commands chan Command
+	stopping bool
+}
+
+type Option func(*Controller)
+
+// WithCommandLatency delays commands by latency of the simulation clock.
+func WithCommandLatency(latency time.Duration) Option {
+	return func(c *Controller) {
+		c.latency = latency
+	}
+}
+
+// WithAckSink passes command acknowledgements to the sink.
+func WithAckSink(acks AckSink) Option {
+	return func(c *Controller) {
+		c.acks = acks
+	}
+}
+
+// VehicleStatus is the state of a running vehicle.
+type VehicleStatus struct {
+	VehicleID int
+	Settings  generator.VehicleSettings
+	State     generator.VehicleState
+	HasState  bool
+}
+
+func New(gen *generator.RandomTelematicsGenerator, sink Sink, maxVehicles int, opts ...Option) *Controller {
+	c := &Controller{
+		gen:         gen,
+		sink:        sink,
+		maxVehicles: maxVehicles,
+		acks:        func(Ack) {},
+		vehicles:    make(map[int]*runner),
+		since:       gen.Clock().Now(),
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Add starts generating records for the vehicle, tuned as with Tune, and
+// returns its ID. Vehicle ID 0 takes the lowest ID above all running vehicles.
+// A vehicle removed before continues its track.
+func (c *Controller) Add(vehicleID int, maxSpeed int, maxTimeStep int) (int, error) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	if c.stopped {
+		return 0, ErrStopped
+	}
+	if vehicleID == 0 {
+		vehicleID = c.nextID()
+	}
+	if _, ok := c.vehicles[vehicleID]; ok {
+		return 0, fmt.Errorf("%w: %d", ErrVehicleExists, vehicleID)
+	}
+	if len(c.vehicles) >= c.maxVehicles {
+		return 0, fmt.Errorf("%w: at most %d vehicles can run", ErrTooManyVehicles, c.maxVehicles)
+	}
+
+	c.tune(vehicleID, maxSpeed, maxTimeStep)
+	c.since = c.gen.Clock().Now()
+
+	r := &runner{stop: make(chan struct{}), done: make(chan struct{}), commands: make(chan Command, commandQueue)}
+	c.vehicles[vehicleID] = r
+	c.wg.Add(1)
+	go func() {
+		defer c.wg.Done()
+		defer close(r.done)
+
+		commandsDone := make(chan struct{})
+		go func() {
+			defer close(commandsDone)
+			c.runCommands(r)
+		}()
+
+		for telematicsData := range c.gen.Generate(vehicleID, r.stop) {
+			c.record(telematicsData)
+		}
+		<-commandsDone
+	}()
+
+	return vehicleID, nil
+}
+
+// nextID returns the lowest vehicle ID above all running vehicles. c.mx must
+// be held.
+func (c *Controller) nextID() int {
+	next := 1
+	for vehicleID := range c.vehicles {
+		if vehicleID >= next {
+			next = vehicleID + 1
+		}
+	}
+	return next
+}
+
+// Remove stops generating records for the vehicle, fails its pending commands
+// and waits until its last record reaches the sink. Its settings are dropped,
+// so a vehicle added again with the same ID starts with the default ones.
+func (c *Controller) Remove(vehicleID int) error {
+	c.mx.Lock()
+	r, ok := c.vehicles[vehicleID]
+	if !ok || r.stopping {
+		c.mx.Unlock()
+		return fmt.Errorf("%w: %d", ErrUnknownVehicle, vehicleID)
+	}
+	r.stopping = true
+	close(r.stop)
+	c.mx.Unlock()
+
+	<-r.done
+	c.gen.ResetSettings(vehicleID)
+
+	c.mx.Lock()
+	delete(c.vehicles, vehicleID)
+	c.mx.Unlock()
+
+	return nil
+}
+
+func (c *Controller) Pause(vehicleID int) error {
+	if err := c.check(vehicleID); err != nil {
+		return err
+	}
+	c.gen.Pause(vehicleID)
+	return nil
+}
+
+func (c *Controller) Resume(vehicleID int) error {
+	if err := c.check(vehicleID); err != nil {
+		return err
+	}
+	c.gen.Resume(vehicleID)
+	c.restartWait()
+	return nil
+}
+
+func (c *Controller) PauseFleet() {
+	c.gen.PauseFleet()
+}
+
+func (c *Controller) ResumeFleet() {
+	c.gen.ResumeFleet()
+	c.restartWait()
+}
+
+func (c *Controller) FleetPaused() bool {
+	return c.gen.FleetPaused()
+}
+
+// Tune sets the max speed and the max time step in seconds of the vehicle,
+// zero values are left unchanged.
+func (c *Controller) Tune(vehicleID int, maxSpeed int, maxTimeStep int) error {
+	if err := c.check(vehicleID); err != nil {
+		return err
+	}
+	c.tune(vehicleID, maxSpeed, maxTimeStep)
+	return nil
+}
+
+func (c *Controller) tune(vehicleID int, maxSpeed int, maxTimeStep int) {
+	if maxSpeed > 0 {
+		c.gen.SetMaxSpeed(vehicleID, maxSpeed)
+	}
+	if maxTimeStep > 0 {
+		c.gen.SetMaxTimeStep(vehicleID, maxTimeStep)
+	}
+}
+
+// Status returns the status of the running vehicle.
+func (c *Controller) Status(vehicleID int) (VehicleStatus, error) {
+	if err := c.check(vehicleID); err != nil {
+		return VehicleStatus{}, err
+	}
+	return c.status(vehicleID), nil
+}
+
+// Vehicles returns the status of every running vehicle ordered by vehicle ID.
+func (c *Controller) Vehicles() []VehicleStatus {
+	c.mx.Lock()
+	ids := make([]int, 0, len(c.vehicles))
+	for vehicleID, r := range c.vehicles {
+		if !r.stopping {
+			ids = append(ids, vehicleID)
+		}
+	}
+	c.mx.Unlock()
+	sort.Ints(ids)
+
+	vehicles := make([]VehicleStatus, 0, len(ids))
+	for _, vehicleID := range ids {
+		vehicles = append(vehicles, c.status(vehicleID))
+	}
+	return vehicles
+}
+
+// Stop removes all vehicles and waits until their last records reach the
+// sink.
+func (c *Controller) Stop() {
+	c.mx.Lock()
+	c.stopped = true
+	for _, r := range c.vehicles {
+		if !r.stopping {
+			r.stopping = true
+			close(r.stop)
+		}
+	}
+	c.mx.Unlock()
+
+	c.wg.Wait()
+}
+
+// Running tells whether the controller has not been stopped.
+func (c *Controller) Running() bool {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	return !c.stopped
+}
+
+// Err returns why the controller does not generate records, nil while it
+// does: it is stopped, every vehicle is paused or no record reached the sink
+// for staleSteps time steps of the slowest running vehicle, waiting from the
+// last time a vehicle was added or resumed at the earliest.
+func (c *Controller) Err() error {
+	if !c.Running() {
+		return errors.New("generator is stopped")
+	}
+	if c.FleetPaused() {
+		return errors.New("fleet is paused")
+	}
+
+	step := minStaleStep
+	running := false
+	for _, v := range c.Vehicles() {
+		if v.Settings.Paused {
+			continue
+		}
+		running = true
+		vehicleStep := time.Duration(v.Settings.MaxTimeStep) * time.Second
+		if v.Settings.Interval > 0 {
+			vehicleStep = v.Settings.Interval
+		}
+		if vehicleStep > step {
+			step = vehicleStep
+		}
+	}
+	if !running {
+		return errors.New("no vehicle is running")
+	}
+
+	c.mx.Lock()
+	last := c.since
+	c.mx.Unlock()
+	if record := time.Unix(0, c.lastRecord.Load()); record.After(last) {
+		last = record
+	}
+	if stale := c.gen.Clock().Now().Sub(last); stale > staleSteps*step {
+		return fmt.Errorf("no record for %v", stale.Round(time.Second))
+	}
+	return nil
+}
+
+// record passes the record to the sink.
+func (c *Controller) record(d models.TelematicsData) {
+	c.sink(d)
+	c.lastRecord.Store(c.gen.Clock().Now().UnixNano())
+}
+
+func (c *Controller) restartWait() {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	c.since = c.gen.Clock().Now()
+}
+
+func (c *Controller) check(vehicleID int) error {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	if r, ok := c.vehicles[vehicleID]; !ok || r.stopping {
+		return fmt.Errorf("%w: %d", ErrUnknownVehicle, vehicleID)
+	}
+	return nil
+}
+
+func (c *Controller) status(vehicleID int) VehicleStatus {
+	state, ok := c.gen.State(vehicleID)
+	return VehicleStatus{
+		VehicleID: vehicleID,
+		Settings:  c.gen.Settings(vehicleID),
+		State:     state,
+		HasState:  ok,
+	}
+}
+
+// Clock returns the simulation clock of the generator.
+func (c *Controller) Clock() *clock.Clock {
+	return c.gen.Clock()
+}
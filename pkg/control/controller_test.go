@@ -0,0 +1,207 @@
+package control
+
+import (
+	"errors"
+	"sync"
+	"telematics-generator/pkg/clock"
+	"telematics-generator/pkg/generator"
+	"telematics-generator/pkg/models"
+	"testing"
+	"time"
+)
+
+type recorder struct {
+	mx     sync.Mutex
+	counts map[int]int
+}
+
+func (r *recorder) add(d models.TelematicsData) {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+	r.counts[d.VehicleID]++
+}
+
+func (r *recorder) count(vehicleID int) int {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+	return r.counts[vehicleID]
+}
+
+func TestController(t *testing.T) {
+	gen := generator.NewRandomTelematicsGenerator(100, 0)
+	r := &recorder{counts: make(map[int]int)}
+	c := New(gen, r.add, 2)
+
+	if _, err := c.Add(1, 0, 0); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+	if vehicleID, err := c.Add(0, 0, 0); err != nil || vehicleID != 2 {
+		t.Fatalf("Add() without an ID = %v, %v, want 2", vehicleID, err)
+	}
+	if _, err := c.Add(1, 0, 0); !errors.Is(err, ErrVehicleExists) {
+		t.Errorf("Add() of a running vehicle error = %v, want ErrVehicleExists", err)
+	}
+	if _, err := c.Add(3, 0, 0); !errors.Is(err, ErrTooManyVehicles) {
+		t.Errorf("Add() above the limit error = %v, want ErrTooManyVehicles", err)
+	}
+
+	if err := c.Tune(2, 10, 0); err != nil {
+		t.Fatalf("Tune() error = %v", err)
+	}
+	if err := c.Pause(1); err != nil {
+		t.Fatalf("Pause() error = %v", err)
+	}
+	time.Sleep(50 * time.Millisecond)
+
+	vehicles := c.Vehicles()
+	if len(vehicles) != 2 || vehicles[0].VehicleID != 1 || !vehicles[0].Settings.Paused ||
+		vehicles[1].Settings.MaxSpeed != 10 || !vehicles[1].HasState || vehicles[1].State.Speed >= 10 {
+		t.Errorf("Vehicles() = %+v, want paused vehicle 1 and vehicle 2 limited to 10", vehicles)
+	}
+
+	paused := r.count(1)
+	time.Sleep(50 * time.Millisecond)
+	if r.count(1) != paused {
+		t.Errorf("paused vehicle generated %d records", r.count(1)-paused)
+	}
+
+	if err := c.Remove(2); err != nil {
+		t.Fatalf("Remove() error = %v", err)
+	}
+	removed := r.count(2)
+	time.Sleep(20 * time.Millisecond)
+	if r.count(2) != removed {
+		t.Errorf("removed vehicle generated %d records", r.count(2)-removed)
+	}
+	for _, err := range []error{c.Remove(2), c.Pause(2), c.Resume(2), c.Tune(2, 5, 5)} {
+		if !errors.Is(err, ErrUnknownVehicle) {
+			t.Errorf("control of a removed vehicle error = %v, want ErrUnknownVehicle", err)
+		}
+	}
+	if _, err := c.Status(2); !errors.Is(err, ErrUnknownVehicle) {
+		t.Errorf("Status() of a removed vehicle error = %v, want ErrUnknownVehicle", err)
+	}
+
+	c.PauseFleet()
+	if !c.FleetPaused() {
+		t.Errorf("FleetPaused() = false after PauseFleet")
+	}
+	if err := c.Resume(1); err != nil {
+		t.Fatalf("Resume() error = %v", err)
+	}
+	c.ResumeFleet()
+	time.Sleep(20 * time.Millisecond)
+	if r.count(1) == paused {
+		t.Errorf("resumed vehicle generated no records")
+	}
+
+	c.Stop()
+	stopped := r.count(1)
+	time.Sleep(20 * time.Millisecond)
+	if r.count(1) != stopped || len(c.Vehicles()) != 0 {
+		t.Errorf("vehicles keep running after Stop()")
+	}
+	if _, err := c.Add(0, 0, 0); !errors.Is(err, ErrStopped) {
+		t.Errorf("Add() after Stop() error = %v, want ErrStopped", err)
+	}
+}
+
+func TestRemoveResetsSettings(t *testing.T) {
+	gen := generator.NewRandomTelematicsGenerator(100, 1)
+	r := &recorder{counts: make(map[int]int)}
+	c := New(gen, r.add, 1)
+	defer c.Stop()
+
+	if _, err := c.Add(1, 10, 0); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+	c.Pause(1)
+	gen.SetInterval(1, time.Hour)
+	gen.SetImmobilised(1, true)
+	gen.SetOutput(1, 2, true)
+	if err := c.Remove(1); err != nil {
+		t.Fatalf("Remove() error = %v", err)
+	}
+
+	if _, err := c.Add(1, 0, 0); err != nil {
+		t.Fatalf("Add() after Remove() error = %v", err)
+	}
+	if got, want := gen.Settings(1), (generator.VehicleSettings{MaxSpeed: 100, MaxTimeStep: 1}); got != want {
+		t.Errorf("Settings() of a vehicle added again = %+v, want %+v", got, want)
+	}
+	added := r.count(1)
+	time.Sleep(50 * time.Millisecond)
+	if r.count(1) == added {
+		t.Errorf("vehicle added again generated no records")
+	}
+}
+
+func TestErr(t *testing.T) {
+	simClock, _ := clock.New(time.Second, 1)
+	simClock.Pause()
+	gen := generator.NewRandomTelematicsGenerator(100, 10, generator.WithClock(simClock))
+	stalled := make(chan struct{})
+	c := New(gen, func(models.TelematicsData) { <-stalled }, 2)
+
+	if err := c.Err(); err == nil {
+		t.Errorf("Err() without vehicles = nil")
+	}
+	if _, err := c.Add(1, 0, 0); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+	if err := c.Err(); err != nil {
+		t.Errorf("Err() of a new vehicle = %v, want nil", err)
+	}
+
+	c.Pause(1)
+	if err := c.Err(); err == nil {
+		t.Errorf("Err() with every vehicle paused = nil")
+	}
+	c.Resume(1)
+	c.PauseFleet()
+	if err := c.Err(); err == nil {
+		t.Errorf("Err() of a paused fleet = nil")
+	}
+	c.ResumeFleet()
+
+	// No record reaches the sink for more than 3 steps of 10s.
+	simClock.Jump(time.Minute)
+	if err := c.Err(); err == nil {
+		t.Errorf("Err() with a stalled sink = nil")
+	}
+
+	close(stalled)
+	deadline := time.Now().Add(time.Second)
+	for c.Err() != nil {
+		if time.Now().After(deadline) {
+			t.Fatalf("Err() after the sink recovered = %v, want nil", c.Err())
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	c.Stop()
+	if err := c.Err(); err == nil {
+		t.Errorf("Err() of a stopped controller = nil")
+	}
+}
+
+func TestErrShortTimeStep(t *testing.T) {
+	simClock, _ := clock.New(time.Second, 1)
+	simClock.Pause()
+	// A max time step under 1s is configured as 0.
+	gen := generator.NewRandomTelematicsGenerator(100, 0, generator.WithClock(simClock))
+	stalled := make(chan struct{})
+	c := New(gen, func(models.TelematicsData) { <-stalled }, 1)
+	defer func() {
+		close(stalled)
+		c.Stop()
+	}()
+
+	if _, err := c.Add(1, 0, 0); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+	simClock.Jump(time.Second)
+	if err := c.Err(); err != nil {
+		t.Errorf("Err() 1s after the vehicle was added = %v, want nil", err)
+	}
+}
@@ -0,0 +1,108 @@
+package fleet
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+)
+
+// Profile describes a class of vehicles, e.g. cars or trucks. Share is the
+// percentage of the fleet the profile takes.
+type Profile struct {
+	Name  string
+	Share int
+}
+
+// DefaultProfile takes the whole fleet when no profiles are configured.
+var DefaultProfile = Profile{Name: "default", Share: 100}
+
+// Fleet assigns vehicles 1 to vehiclesCount to profiles in order of the
+// profiles and proportionally to their shares. Vehicles added at runtime
+// above vehiclesCount take the first profile.
+type Fleet struct {
+	profiles []Profile
+	vehicles map[int]int
+}
+
+// Validate checks the profiles: unique names and shares adding up to 100.
+func Validate(profiles []Profile) error {
+	if len(profiles) == 0 {
+		return errors.New("at least one fleet profile is required")
+	}
+
+	names := make(map[string]bool)
+	total := 0
+	for _, p := range profiles {
+		if p.Name == "" {
+			return errors.New("fleet profile name is required")
+		}
+		if names[p.Name] {
+			return fmt.Errorf("fleet profile %s is defined twice", p.Name)
+		}
+		names[p.Name] = true
+		if p.Share < 1 {
+			return fmt.Errorf("share of fleet profile %s should be more than 1", p.Name)
+		}
+		total += p.Share
+	}
+	if total != 100 {
+		return fmt.Errorf("shares of fleet profiles should add up to 100, got %d", total)
+	}
+
+	return nil
+}
+
+func New(profiles []Profile, vehiclesCount int) *Fleet {
+	f := &Fleet{
+		profiles: profiles,
+		vehicles: make(map[int]int, vehiclesCount),
+	}
+
+	profile, bound := 0, profiles[0].Share
+	for i := 0; i < vehiclesCount; i++ {
+		for i*100 >= bound*vehiclesCount && profile < len(profiles)-1 {
+			profile++
+			bound += profiles[profile].Share
+		}
+		f.vehicles[i+1] = profile
+	}
+
+	return f
+}
+
+// ProfileOf returns the profile of the vehicle, false for IDs no vehicle can
+// have.
+func (f *Fleet) ProfileOf(vehicleID int) (Profile, bool) {
+	if vehicleID < 1 {
+		return Profile{}, false
+	}
+	return f.profiles[f.vehicles[vehicleID]], true
+}
+
+// Profile returns the profile with the given name.
+func (f *Fleet) Profile(name string) (Profile, bool) {
+	for _, p := range f.profiles {
+		if p.Name == name {
+			return p, true
+		}
+	}
+	return Profile{}, false
+}
+
+// Profiles returns the profiles in the order they were given.
+func (f *Fleet) Profiles() []Profile {
+	return append([]Profile(nil), f.profiles...)
+}
+
+// Vehicles returns the IDs of the vehicles 1 to vehiclesCount of the profile
+// in ascending order.
+func (f *Fleet) Vehicles(profile string) []int {
+	var vehicles []int
+	for vehicleID, i := range f.vehicles {
+		if f.profiles[i].Name == profile {
+			vehicles = append(vehicles, vehicleID)
+		}
+	}
+	sort.Ints(vehicles)
+	return vehicles
+}
@@ -0,0 +1,50 @@
+package fleet
+
+import "testing"
+
+func TestNew(t *testing.T) {
+	profiles := []Profile{
+		{Name: "car", Share: 60},
+		{Name: "truck", Share: 30},
+		{Name: "bus", Share: 10},
+	}
+	if err := Validate(profiles); err != nil {
+		t.Fatalf("Validate() error = %v", err)
+	}
+
+	f := New(profiles, 10)
+	want := map[string]int{"car": 6, "truck": 3, "bus": 1}
+	for name, count := range want {
+		if vehicles := f.Vehicles(name); len(vehicles) != count {
+			t.Errorf("Vehicles(%v) = %v, want %v vehicles", name, vehicles, count)
+		}
+	}
+	if p, ok := f.ProfileOf(10); !ok || p.Name != "bus" {
+		t.Errorf("ProfileOf(10) = %v, %v, want bus", p, ok)
+	}
+	if p, ok := f.ProfileOf(11); !ok || p.Name != "car" {
+		t.Errorf("ProfileOf(11) of a vehicle added at runtime = %v, %v, want car", p, ok)
+	}
+	if _, ok := f.ProfileOf(0); ok {
+		t.Errorf("ProfileOf(0) should fail")
+	}
+
+	f = New(profiles, 1)
+	if p, _ := f.ProfileOf(1); p.Name != "car" {
+		t.Errorf("ProfileOf(1) of a single vehicle fleet = %v, want car", p)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	invalid := [][]Profile{
+		nil,
+		{{Name: "car", Share: 50}},
+		{{Name: "car", Share: 50}, {Name: "car", Share: 50}},
+		{{Name: "", Share: 100}},
+	}
+	for _, profiles := range invalid {
+		if err := Validate(profiles); err == nil {
+			t.Errorf("Validate(%v) should return an error", profiles)
+		}
+	}
+}
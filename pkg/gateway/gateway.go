@@ -0,0 +1,312 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// maxBodySize limits the JSON body of a request.
+const maxBodySize = 1 << 20
+
+// Service is a gRPC service and its implementation exposed by the gateway.
+type Service struct {
+	Desc *grpc.ServiceDesc
+	Impl interface{}
+}
+
+// Gateway serves every unary and server streaming method of its services as
+// a JSON endpoint /v1/<method>. The request is read from the query
+// parameters of a GET request or from the JSON body of a POST request.
+// Unary methods respond with a JSON message, streaming ones with one JSON
+// message per line (NDJSON) as they are sent. Calls go through the same
+// interceptors as on the gRPC server if they are given as options.
+type Gateway struct {
+	methods            map[string]method
+	unaryInterceptors  []grpc.UnaryServerInterceptor
+	streamInterceptors []grpc.StreamServerInterceptor
+}
+
+type method struct {
+	impl       interface{}
+	fullMethod string
+	unary      unaryHandler
+	stream     grpc.StreamHandler
+}
+
+type Option func(*Gateway)
+
+// WithUnaryInterceptors runs unary calls through the interceptors, the first
+// one outermost, as grpc.ChainUnaryInterceptor does.
+func WithUnaryInterceptors(interceptors ...grpc.UnaryServerInterceptor) Option {
+	return func(g *Gateway) {
+		g.unaryInterceptors = append(g.unaryInterceptors, interceptors...)
+	}
+}
+
+// WithStreamInterceptors runs streaming calls through the interceptors, the
+// first one outermost, as grpc.ChainStreamInterceptor does.
+func WithStreamInterceptors(interceptors ...grpc.StreamServerInterceptor) Option {
+	return func(g *Gateway) {
+		g.streamInterceptors = append(g.streamInterceptors, interceptors...)
+	}
+}
+
+// unaryHandler is the type of the handlers of grpc.MethodDesc.
+type unaryHandler func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error)
+
+func New(services []Service, opts ...Option) *Gateway {
+	g := &Gateway{methods: make(map[string]method)}
+	for _, s := range services {
+		for _, m := range s.Desc.Methods {
+			g.methods[m.MethodName] = method{
+				impl:       s.Impl,
+				fullMethod: "/" + s.Desc.ServiceName + "/" + m.MethodName,
+				unary:      unaryHandler(m.Handler),
+			}
+		}
+		for _, m := range s.Desc.Streams {
+			if m.ServerStreams && !m.ClientStreams {
+				g.methods[m.StreamName] = method{
+					impl:       s.Impl,
+					fullMethod: "/" + s.Desc.ServiceName + "/" + m.StreamName,
+					stream:     m.Handler,
+				}
+			}
+		}
+	}
+	for _, opt := range opts {
+		opt(g)
+	}
+	return g
+}
+
+// Methods returns the names of the exposed methods in alphabetical order.
+func (g *Gateway) Methods() []string {
+	names := make([]string, 0, len(g.methods))
+	for name := range g.methods {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (g *Gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	name, ok := strings.CutPrefix(r.URL.Path, "/v1/")
+	m, found := g.methods[name]
+	if !ok || !found {
+		writeError(w, status.Errorf(codes.NotFound, "unknown method %s", r.URL.Path))
+		return
+	}
+	if r.Method != http.MethodGet && r.Method != http.MethodPost {
+		w.Header().Set("Allow", "GET, POST")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	if m.unary != nil {
+		resp, err := m.unary(m.impl, r.Context(), func(req interface{}) error {
+			return readRequest(r, req.(proto.Message))
+		}, g.unaryInterceptor())
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		body, err := protojson.Marshal(resp.(proto.Message))
+		if err != nil {
+			writeError(w, status.Error(codes.Internal, err.Error()))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(body)
+		return
+	}
+
+	stream := &serverStream{w: w, r: r}
+	if err := g.runStream(m, stream); err != nil {
+		if !stream.started {
+			writeError(w, err)
+			return
+		}
+		// The status is already sent, the error ends the stream instead.
+		body, _ := protojson.Marshal(status.Convert(err).Proto())
+		fmt.Fprintf(w, "{\"error\":%s}\n", body)
+	}
+	stream.writeTrailer()
+}
+
+// unaryInterceptor chains the unary interceptors, nil if there are none.
+func (g *Gateway) unaryInterceptor() grpc.UnaryServerInterceptor {
+	if len(g.unaryInterceptors) == 0 {
+		return nil
+	}
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		for i := len(g.unaryInterceptors) - 1; i >= 0; i-- {
+			interceptor, next := g.unaryInterceptors[i], handler
+			handler = func(ctx context.Context, req interface{}) (interface{}, error) {
+				return interceptor(ctx, req, info, next)
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+// runStream calls the streaming method through the stream interceptors.
+func (g *Gateway) runStream(m method, stream grpc.ServerStream) error {
+	info := &grpc.StreamServerInfo{FullMethod: m.fullMethod, IsServerStream: true}
+	handler := m.stream
+	for i := len(g.streamInterceptors) - 1; i >= 0; i-- {
+		interceptor, next := g.streamInterceptors[i], handler
+		handler = func(srv interface{}, stream grpc.ServerStream) error {
+			return interceptor(srv, stream, info, next)
+		}
+	}
+	return handler(m.impl, stream)
+}
+
+func readRequest(r *http.Request, req proto.Message) error {
+	if r.Method == http.MethodPost {
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxBodySize+1))
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "failed to read body: %v", err)
+		}
+		if len(body) > maxBodySize {
+			return status.Errorf(codes.InvalidArgument, "body should be at most %d bytes", maxBodySize)
+		}
+		if len(body) > 0 {
+			if err := protojson.Unmarshal(body, req); err != nil {
+				return status.Errorf(codes.InvalidArgument, "invalid body: %v", err)
+			}
+		}
+	}
+	if err := decodeQuery(r.URL.Query(), req); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return nil
+}
+
+// serverStream passes the messages a streaming method sends to the HTTP
+// response as NDJSON, flushing each one.
+type serverStream struct {
+	w       http.ResponseWriter
+	r       *http.Request
+	started bool
+	header  metadata.MD
+	trailer metadata.MD
+}
+
+func (s *serverStream) SetHeader(md metadata.MD) error {
+	if s.started {
+		return status.Error(codes.Internal, "headers are already sent")
+	}
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func (s *serverStream) SendHeader(md metadata.MD) error {
+	if err := s.SetHeader(md); err != nil {
+		return err
+	}
+	s.start()
+	return nil
+}
+
+func (s *serverStream) SetTrailer(md metadata.MD) {
+	s.trailer = metadata.Join(s.trailer, md)
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.r.Context()
+}
+
+func (s *serverStream) SendMsg(m interface{}) error {
+	body, err := protojson.Marshal(m.(proto.Message))
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	s.start()
+	if _, err := s.w.Write(append(body, '\n')); err != nil {
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	if f, ok := s.w.(http.Flusher); ok {
+		f.Flush()
+	}
+	return nil
+}
+
+func (s *serverStream) RecvMsg(m interface{}) error {
+	return readRequest(s.r, m.(proto.Message))
+}
+
+func (s *serverStream) start() {
+	if s.started {
+		return
+	}
+	s.started = true
+	for key, values := range s.header {
+		for _, v := range values {
+			s.w.Header().Add(key, v)
+		}
+	}
+	s.w.Header().Set("Content-Type", "application/x-ndjson")
+	s.w.WriteHeader(http.StatusOK)
+}
+
+// writeTrailer sends the trailer metadata, e.g. the next page token, as HTTP
+// trailers.
+func (s *serverStream) writeTrailer() {
+	s.start()
+	for key, values := range s.trailer {
+		for _, v := range values {
+			s.w.Header().Add(http.TrailerPrefix+key, v)
+		}
+	}
+}
+
+func writeError(w http.ResponseWriter, err error) {
+	st := status.Convert(err)
+	body, _ := protojson.Marshal(st.Proto())
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatus(st.Code()))
+	w.Write(body)
+}
+
+// httpStatus maps gRPC codes to HTTP statuses as grpc-gateway does.
+func httpStatus(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.Canceled:
+		return 499
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
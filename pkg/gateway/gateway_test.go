@@ -0,0 +1,200 @@
+package gateway
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"telematics-generator/pkg/cache"
+	mygrpc "telematics-generator/pkg/grpc"
+	"telematics-generator/pkg/models"
+	"telematics-generator/protobuf"
+)
+
+func TestGateway(t *testing.T) {
+	c := cache.NewTelematicsDataCache(100)
+	start := time.Date(2023, 7, 12, 10, 0, 0, 0, time.UTC)
+	for i := 0; i < 6; i++ {
+		c.Add(models.TelematicsData{VehicleID: i%3 + 1, Timestamp: start.Add(time.Duration(i) * time.Second), Speed: i})
+	}
+	g := New([]Service{{Desc: &protobuf.TelematicsDataService_ServiceDesc, Impl: mygrpc.NewServer(c)}})
+	srv := httptest.NewServer(g)
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + "/v1/GetLatestData")
+	if err != nil {
+		t.Fatalf("GET GetLatestData error = %v", err)
+	}
+	var latest protobuf.TelematicsDataProto
+	body, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err := protojson.Unmarshal(body, &latest); err != nil || resp.StatusCode != http.StatusOK || latest.Speed != 5 {
+		t.Errorf("GET GetLatestData = %v %s, want the record of speed 5", resp.Status, body)
+	}
+
+	resp, err = http.Post(srv.URL+"/v1/GetVehicleLatest", "application/json", strings.NewReader(`{"vehicleId": 1}`))
+	if err != nil {
+		t.Fatalf("POST GetVehicleLatest error = %v", err)
+	}
+	body, _ = io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err := protojson.Unmarshal(body, &latest); err != nil || latest.VehicleId != 1 || latest.Speed != 3 {
+		t.Errorf("POST GetVehicleLatest = %v %s, want the latest record of vehicle 1", resp.Status, body)
+	}
+
+	query := url.Values{
+		"from_timestamp": {"2023-07-12T09:59:59Z"},
+		"toTimestamp":    {"1689156010000000000"},
+		"order":          {"ORDER_ASC"},
+		"vehicle_ids":    {"1,2"},
+		"limit":          {"3"},
+	}
+	resp, err = http.Get(srv.URL + "/v1/GetRangeData?" + query.Encode())
+	if err != nil {
+		t.Fatalf("GET GetRangeData error = %v", err)
+	}
+	if ct := resp.Header.Get("Content-Type"); ct != "application/x-ndjson" {
+		t.Errorf("GET GetRangeData content type = %v", ct)
+	}
+	var speeds []int32
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		var d protobuf.TelematicsDataProto
+		if err := protojson.Unmarshal(scanner.Bytes(), &d); err != nil {
+			t.Fatalf("GET GetRangeData line %q: %v", scanner.Text(), err)
+		}
+		speeds = append(speeds, d.Speed)
+	}
+	resp.Body.Close()
+	if len(speeds) != 3 || speeds[0] != 0 || speeds[1] != 1 || speeds[2] != 3 {
+		t.Errorf("GET GetRangeData speeds = %v, want 0, 1 and 3", speeds)
+	}
+	if resp.Trailer.Get(mygrpc.NextPageTokenKey) == "" {
+		t.Errorf("GET GetRangeData trailer = %v, want a next page token", resp.Trailer)
+	}
+
+	for _, tc := range []struct {
+		path string
+		code int
+	}{
+		{"/v1/GetRangeData?from_timestamp=yesterday", http.StatusBadRequest},
+		{"/v1/GetRangeData?order=SIDEWAYS", http.StatusBadRequest},
+		{"/v1/GetRangeData?no_such_field=1", http.StatusBadRequest},
+		{"/v1/GetRangeData?from_timestamp=2&to_timestamp=1", http.StatusBadRequest},
+		{"/v1/GetVehicleLatest?vehicle_id=42", http.StatusNotFound},
+		{"/v1/NoSuchMethod", http.StatusNotFound},
+	} {
+		resp, err := http.Get(srv.URL + tc.path)
+		if err != nil {
+			t.Fatalf("GET %s error = %v", tc.path, err)
+		}
+		var st struct {
+			Code    int
+			Message string
+		}
+		err = json.NewDecoder(resp.Body).Decode(&st)
+		resp.Body.Close()
+		if resp.StatusCode != tc.code || err != nil || st.Message == "" {
+			t.Errorf("GET %s = %v with status %+v, %v, want %d", tc.path, resp.Status, st, err, tc.code)
+		}
+	}
+}
+
+func TestGatewayInterceptors(t *testing.T) {
+	c := cache.NewTelematicsDataCache(100)
+	c.Add(models.TelematicsData{VehicleID: 1, Timestamp: time.Date(2023, 7, 12, 10, 0, 0, 0, time.UTC)})
+	var unary, stream []string
+	g := New([]Service{{Desc: &protobuf.TelematicsDataService_ServiceDesc, Impl: mygrpc.NewServer(c)}},
+		WithUnaryInterceptors(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			unary = append(unary, info.FullMethod)
+			return nil, status.Error(codes.Unauthenticated, "no token")
+		}),
+		WithStreamInterceptors(func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			stream = append(stream, info.FullMethod)
+			return handler(srv, ss)
+		}))
+	srv := httptest.NewServer(g)
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL + "/v1/GetLatestData")
+	if err != nil {
+		t.Fatalf("GET GetLatestData error = %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusUnauthorized {
+		t.Errorf("GET GetLatestData = %v, want the status of the interceptor", resp.Status)
+	}
+	if len(unary) != 1 || unary[0] != "/proto.TelematicsDataService/GetLatestData" {
+		t.Errorf("unary interceptor calls = %v", unary)
+	}
+
+	resp, err = http.Get(srv.URL + "/v1/GetRangeData?from_timestamp=2023-07-12T09:00:00Z&to_timestamp=2023-07-12T11:00:00Z")
+	if err != nil {
+		t.Fatalf("GET GetRangeData error = %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Errorf("GET GetRangeData = %v, want 200 OK", resp.Status)
+	}
+	if len(stream) != 1 || stream[0] != "/proto.TelematicsDataService/GetRangeData" {
+		t.Errorf("stream interceptor calls = %v", stream)
+	}
+}
+
+func TestDecodeQuery(t *testing.T) {
+	var req protobuf.SubscribeRequest
+	err := decodeQuery(url.Values{
+		"vehicle_ids":               {"1,2", "3"},
+		"fleetProfiles":             {"car"},
+		"bounding_box.min.latitude": {"50.5"},
+		"boundingBox.max.longitude": {"31"},
+		"from_timestamp":            {"2023-07-12T10:00:00.5+03:00"},
+	}, &req)
+	if err != nil {
+		t.Fatalf("decodeQuery() error = %v", err)
+	}
+	want := time.Date(2023, 7, 12, 7, 0, 0, 5e8, time.UTC).UnixNano()
+	if len(req.VehicleIds) != 3 || req.VehicleIds[2] != 3 || len(req.FleetProfiles) != 1 ||
+		req.BoundingBox.GetMin().GetLatitude() != 50.5 || req.BoundingBox.GetMax().GetLongitude() != 31 ||
+		req.FromTimestamp != want {
+		t.Errorf("decodeQuery() = %v", &req)
+	}
+
+	var rangeReq protobuf.RangeDataRequest
+	err = decodeQuery(url.Values{
+		"resample_interval": {"15s"},
+		"fields":            {"vehicle_id,speed"},
+		"resolution":        {"2"},
+		"to_timestamp":      {"2023-07-12"},
+	}, &rangeReq)
+	if err != nil {
+		t.Fatalf("decodeQuery() error = %v", err)
+	}
+	if rangeReq.ResampleInterval.AsDuration() != 15*time.Second || len(rangeReq.Fields.GetPaths()) != 2 ||
+		rangeReq.Resolution != protobuf.Resolution_RESOLUTION_HOUR ||
+		rangeReq.ToTimestamp != time.Date(2023, 7, 12, 0, 0, 0, 0, time.UTC).UnixNano() {
+		t.Errorf("decodeQuery() = %v", &rangeReq)
+	}
+
+	for _, query := range []url.Values{
+		{"limit": {"1", "2"}},
+		{"limit": {"ten"}},
+		{"fields.paths": {"speed"}},
+		{"vehicle_ids.x": {"1"}},
+	} {
+		if err := decodeQuery(query, &protobuf.RangeDataRequest{}); err == nil {
+			t.Errorf("decodeQuery(%v) error = nil", query)
+		}
+	}
+}
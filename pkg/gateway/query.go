@@ -0,0 +1,195 @@
+package gateway
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// timestampLayouts are the ISO-8601 forms accepted for timestamps, the ones
+// without a zone are in UTC.
+var timestampLayouts = []string{time.RFC3339Nano, "2006-01-02T15:04:05.999999999", "2006-01-02"}
+
+// decodeQuery sets the fields of the message from the query parameters. A
+// parameter is named by the proto or the JSON name of a field, fields of
+// nested messages are joined by dots, e.g. bounding_box.min.latitude.
+// Repeated fields take repeated parameters or comma separated values.
+// int64 fields named timestamp or ending in _timestamp take nanoseconds
+// since the epoch or an ISO-8601 time.
+func decodeQuery(query url.Values, m proto.Message) error {
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if err := setField(m.ProtoReflect(), key, strings.Split(key, "."), query[key]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func setField(m protoreflect.Message, key string, path []string, values []string) error {
+	fields := m.Descriptor().Fields()
+	fd := fields.ByName(protoreflect.Name(path[0]))
+	if fd == nil {
+		fd = fields.ByJSONName(path[0])
+	}
+	if fd == nil {
+		return fmt.Errorf("unknown parameter %s", key)
+	}
+	if fd.IsMap() {
+		return fmt.Errorf("%s should be set in the JSON body", key)
+	}
+
+	if len(path) > 1 {
+		if fd.Kind() != protoreflect.MessageKind || fd.IsList() || isWellKnown(fd) {
+			return fmt.Errorf("unknown parameter %s", key)
+		}
+		return setField(m.Mutable(fd).Message(), key, path[1:], values)
+	}
+
+	if fd.IsList() {
+		list := m.Mutable(fd).List()
+		for _, value := range values {
+			for _, item := range strings.Split(value, ",") {
+				v, err := parseValue(fd, item)
+				if err != nil {
+					return fmt.Errorf("%s %w", key, err)
+				}
+				list.Append(v)
+			}
+		}
+		return nil
+	}
+
+	if len(values) > 1 {
+		return fmt.Errorf("%s should be given once", key)
+	}
+	v, err := parseValue(fd, values[0])
+	if err != nil {
+		return fmt.Errorf("%s %w", key, err)
+	}
+	m.Set(fd, v)
+	return nil
+}
+
+func parseValue(fd protoreflect.FieldDescriptor, s string) (protoreflect.Value, error) {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		v, err := strconv.ParseBool(s)
+		if err != nil {
+			return protoreflect.Value{}, fmt.Errorf("should be true or false")
+		}
+		return protoreflect.ValueOfBool(v), nil
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		v, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return protoreflect.Value{}, fmt.Errorf("should be a 32-bit integer")
+		}
+		return protoreflect.ValueOfInt32(int32(v)), nil
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		if isTimestamp(fd) {
+			v, err := parseTimestamp(s)
+			if err != nil {
+				return protoreflect.Value{}, err
+			}
+			return protoreflect.ValueOfInt64(v), nil
+		}
+		v, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return protoreflect.Value{}, fmt.Errorf("should be an integer")
+		}
+		return protoreflect.ValueOfInt64(v), nil
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		v, err := strconv.ParseUint(s, 10, 32)
+		if err != nil {
+			return protoreflect.Value{}, fmt.Errorf("should be an unsigned 32-bit integer")
+		}
+		return protoreflect.ValueOfUint32(uint32(v)), nil
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		v, err := strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			return protoreflect.Value{}, fmt.Errorf("should be an unsigned integer")
+		}
+		return protoreflect.ValueOfUint64(v), nil
+	case protoreflect.FloatKind:
+		v, err := strconv.ParseFloat(s, 32)
+		if err != nil {
+			return protoreflect.Value{}, fmt.Errorf("should be a number")
+		}
+		return protoreflect.ValueOfFloat32(float32(v)), nil
+	case protoreflect.DoubleKind:
+		v, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return protoreflect.Value{}, fmt.Errorf("should be a number")
+		}
+		return protoreflect.ValueOfFloat64(v), nil
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(s), nil
+	case protoreflect.EnumKind:
+		values := fd.Enum().Values()
+		if v := values.ByName(protoreflect.Name(s)); v != nil {
+			return protoreflect.ValueOfEnum(v.Number()), nil
+		}
+		n, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return protoreflect.Value{}, fmt.Errorf("should be one of %s", enumNames(values))
+		}
+		return protoreflect.ValueOfEnum(protoreflect.EnumNumber(n)), nil
+	case protoreflect.MessageKind:
+		switch fd.Message().FullName() {
+		case "google.protobuf.Duration":
+			d, err := time.ParseDuration(s)
+			if err != nil {
+				return protoreflect.Value{}, fmt.Errorf("should be a duration like 1m30s")
+			}
+			return protoreflect.ValueOfMessage(durationpb.New(d).ProtoReflect()), nil
+		case "google.protobuf.FieldMask":
+			return protoreflect.ValueOfMessage((&fieldmaskpb.FieldMask{Paths: strings.Split(s, ",")}).ProtoReflect()), nil
+		}
+		return protoreflect.Value{}, fmt.Errorf("should be set by its fields or in the JSON body")
+	}
+	return protoreflect.Value{}, fmt.Errorf("should be set in the JSON body")
+}
+
+func isWellKnown(fd protoreflect.FieldDescriptor) bool {
+	name := fd.Message().FullName()
+	return name == "google.protobuf.Duration" || name == "google.protobuf.FieldMask"
+}
+
+func isTimestamp(fd protoreflect.FieldDescriptor) bool {
+	return fd.Name() == "timestamp" || strings.HasSuffix(string(fd.Name()), "_timestamp")
+}
+
+// parseTimestamp returns the nanoseconds since the epoch of an integer or an
+// ISO-8601 time.
+func parseTimestamp(s string) (int64, error) {
+	if v, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return v, nil
+	}
+	for _, layout := range timestampLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t.UnixNano(), nil
+		}
+	}
+	return 0, fmt.Errorf("should be nanoseconds since the epoch or an ISO-8601 time like 2023-07-12T10:00:00Z")
+}
+
+func enumNames(values protoreflect.EnumValueDescriptors) string {
+	names := make([]string, values.Len())
+	for i := range names {
+		names[i] = string(values.Get(i).Name())
+	}
+	return strings.Join(names, ", ")
+}
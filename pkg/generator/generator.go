@@ -3,6 +3,9 @@ package generator
 import (
 	geo "github.com/kellydunn/golang-geo"
 	"math/rand"
+	"sort"
+	"sync"
+	"telematics-generator/pkg/clock"
 	"telematics-generator/pkg/models"
 	"time"
 )
@@ -14,30 +17,315 @@ type Generator interface {
 type RandomTelematicsGenerator struct {
 	maxSpeed    int
 	maxTimeStep int
+	clock       *clock.Clock
+	mx          sync.Mutex
+	states      map[int]VehicleState
+	settings    map[int]VehicleSettings
+	fleetPaused bool
+	// changed is closed and replaced whenever a vehicle is paused or resumed.
+	changed chan struct{}
 }
 
-func NewRandomTelematicsGenerator(maxSpeedArg int, maxTimeStepArg int) *RandomTelematicsGenerator {
-	return &RandomTelematicsGenerator{
+// VehicleSettings are the settings of one vehicle. Zero MaxSpeed and
+// MaxTimeStep fall back to the ones of the generator. A non-zero Interval
+// replaces the random time step, an immobilised vehicle reports its position
+// without moving. Outputs holds the state of the device outputs, bit n for
+// output n.
+type VehicleSettings struct {
+	MaxSpeed    int
+	MaxTimeStep int
+	Paused      bool
+	Interval    time.Duration
+	Immobilised bool
+	Outputs     uint32
+}
+
+type Option func(*RandomTelematicsGenerator)
+
+// WithClock makes the generator follow the simulation clock instead of the
+// wall clock.
+func WithClock(c *clock.Clock) Option {
+	return func(g *RandomTelematicsGenerator) {
+		g.clock = c
+	}
+}
+
+func NewRandomTelematicsGenerator(maxSpeedArg int, maxTimeStepArg int, opts ...Option) *RandomTelematicsGenerator {
+	g := &RandomTelematicsGenerator{
 		maxSpeed:    maxSpeedArg,
 		maxTimeStep: maxTimeStepArg,
+		states:      make(map[int]VehicleState),
+		settings:    make(map[int]VehicleSettings),
+		changed:     make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(g)
+	}
+	if g.clock == nil {
+		g.clock, _ = clock.New(time.Second, 1)
+	}
+
+	return g
+}
+
+// Clock returns the clock the records are timestamped by.
+func (g *RandomTelematicsGenerator) Clock() *clock.Clock {
+	return g.clock
+}
+
+// SetMaxSpeed limits the speed of one vehicle below the max speed of the
+// generator.
+func (g *RandomTelematicsGenerator) SetMaxSpeed(vehicleID int, maxSpeed int) {
+	g.mx.Lock()
+	defer g.mx.Unlock()
+
+	settings := g.settings[vehicleID]
+	settings.MaxSpeed = maxSpeed
+	g.settings[vehicleID] = settings
+}
+
+// SetMaxTimeStep sets the max time step of one vehicle in seconds, taking
+// effect after its current step.
+func (g *RandomTelematicsGenerator) SetMaxTimeStep(vehicleID int, maxTimeStep int) {
+	g.mx.Lock()
+	defer g.mx.Unlock()
+
+	settings := g.settings[vehicleID]
+	settings.MaxTimeStep = maxTimeStep
+	g.settings[vehicleID] = settings
+}
+
+// SetInterval makes the vehicle report every interval instead of after a
+// random time step, taking effect after its current step. Zero restores the
+// random time step.
+func (g *RandomTelematicsGenerator) SetInterval(vehicleID int, interval time.Duration) {
+	g.mx.Lock()
+	defer g.mx.Unlock()
+
+	settings := g.settings[vehicleID]
+	settings.Interval = interval
+	g.settings[vehicleID] = settings
+}
+
+// SetImmobilised stops the vehicle from moving from its next record on, or
+// releases it.
+func (g *RandomTelematicsGenerator) SetImmobilised(vehicleID int, immobilised bool) {
+	g.mx.Lock()
+	defer g.mx.Unlock()
+
+	settings := g.settings[vehicleID]
+	settings.Immobilised = immobilised
+	g.settings[vehicleID] = settings
+}
+
+// SetOutput switches output n of the vehicle device on or off.
+func (g *RandomTelematicsGenerator) SetOutput(vehicleID int, n int, on bool) {
+	g.mx.Lock()
+	defer g.mx.Unlock()
+
+	settings := g.settings[vehicleID]
+	if on {
+		settings.Outputs |= 1 << n
+	} else {
+		settings.Outputs &^= 1 << n
 	}
+	g.settings[vehicleID] = settings
+}
+
+// ResetSettings drops the settings of the vehicle, so it runs with the ones
+// of the generator when it is added again.
+func (g *RandomTelematicsGenerator) ResetSettings(vehicleID int) {
+	g.mx.Lock()
+	defer g.mx.Unlock()
+
+	delete(g.settings, vehicleID)
+	g.notify()
+}
+
+// Pause stops generating records for the vehicle until Resume.
+func (g *RandomTelematicsGenerator) Pause(vehicleID int) {
+	g.setPaused(vehicleID, true)
+}
+
+func (g *RandomTelematicsGenerator) Resume(vehicleID int) {
+	g.setPaused(vehicleID, false)
+}
+
+// PauseFleet stops generating records for all vehicles until ResumeFleet.
+// Vehicles paused one by one stay paused after ResumeFleet.
+func (g *RandomTelematicsGenerator) PauseFleet() {
+	g.mx.Lock()
+	defer g.mx.Unlock()
+
+	g.fleetPaused = true
+	g.notify()
+}
+
+func (g *RandomTelematicsGenerator) ResumeFleet() {
+	g.mx.Lock()
+	defer g.mx.Unlock()
+
+	g.fleetPaused = false
+	g.notify()
+}
+
+func (g *RandomTelematicsGenerator) FleetPaused() bool {
+	g.mx.Lock()
+	defer g.mx.Unlock()
+
+	return g.fleetPaused
+}
+
+// Settings returns the effective settings of the vehicle.
+func (g *RandomTelematicsGenerator) Settings(vehicleID int) VehicleSettings {
+	g.mx.Lock()
+	defer g.mx.Unlock()
+
+	settings := g.settings[vehicleID]
+	if settings.MaxSpeed == 0 || settings.MaxSpeed > g.maxSpeed {
+		settings.MaxSpeed = g.maxSpeed
+	}
+	if settings.MaxTimeStep == 0 {
+		settings.MaxTimeStep = g.maxTimeStep
+	}
+	return settings
+}
+
+// State returns the current state of the vehicle, if it has any.
+func (g *RandomTelematicsGenerator) State(vehicleID int) (VehicleState, bool) {
+	g.mx.Lock()
+	defer g.mx.Unlock()
+
+	state, ok := g.states[vehicleID]
+	return state, ok
+}
+
+func (g *RandomTelematicsGenerator) setPaused(vehicleID int, paused bool) {
+	g.mx.Lock()
+	defer g.mx.Unlock()
+
+	settings := g.settings[vehicleID]
+	settings.Paused = paused
+	g.settings[vehicleID] = settings
+	g.notify()
+}
+
+func (g *RandomTelematicsGenerator) notify() {
+	close(g.changed)
+	g.changed = make(chan struct{})
+}
+
+// paused tells whether the vehicle is paused and returns the channel closed
+// on the next change.
+func (g *RandomTelematicsGenerator) paused(vehicleID int) (bool, <-chan struct{}) {
+	g.mx.Lock()
+	defer g.mx.Unlock()
+
+	return g.fleetPaused || g.settings[vehicleID].Paused, g.changed
+}
+
+// Restore replaces the state of the given vehicles, so that the next Generate
+// call for them continues the saved track instead of starting a new one.
+func (g *RandomTelematicsGenerator) Restore(states []VehicleState) {
+	g.mx.Lock()
+	defer g.mx.Unlock()
+
+	for _, state := range states {
+		g.states[state.VehicleID] = state
+	}
+}
+
+// Snapshot returns the current state of every vehicle ordered by vehicle ID.
+func (g *RandomTelematicsGenerator) Snapshot() []VehicleState {
+	g.mx.Lock()
+	defer g.mx.Unlock()
+
+	states := make([]VehicleState, 0, len(g.states))
+	for _, state := range g.states {
+		states = append(states, state)
+	}
+	sort.Slice(states, func(i, j int) bool {
+		return states[i].VehicleID < states[j].VehicleID
+	})
+
+	return states
+}
+
+func (g *RandomTelematicsGenerator) vehicleState(vehicleID int) VehicleState {
+	g.mx.Lock()
+	defer g.mx.Unlock()
+
+	state, ok := g.states[vehicleID]
+	if !ok {
+		src := newSource(rand.Int63())
+		rnd := rand.New(src)
+		state = VehicleState{
+			VehicleID: vehicleID,
+			Latitude:  rnd.Float64()*180 - 90,
+			Longitude: rnd.Float64()*360 - 180,
+			RandState: src.state,
+		}
+		g.states[vehicleID] = state
+	}
+
+	return state
+}
+
+func (g *RandomTelematicsGenerator) setVehicleState(state VehicleState) {
+	g.mx.Lock()
+	defer g.mx.Unlock()
+
+	g.states[state.VehicleID] = state
 }
 
 func (g *RandomTelematicsGenerator) Generate(vehicleID int, stop chan struct{}) <-chan models.TelematicsData {
 	out := make(chan models.TelematicsData)
+	state := g.vehicleState(vehicleID)
 
 	go func() {
-		latitude := rand.Float64()*180 - 90
-		longitude := rand.Float64()*360 - 180
+		src := newSource(0)
+		src.state = state.RandState
+		rnd := rand.New(src)
 
+		// Records are timestamped by schedule rather than by the time they
+		// are generated, so that a vehicle falling behind the clock after a
+		// jump catches up without gaps in its track.
+		next := g.clock.Now()
 		for {
-			deltaTime := rand.Float64() * float64(g.maxTimeStep)
-			speed := rand.Intn(g.maxSpeed)
+			for waited := false; ; waited = true {
+				paused, changed := g.paused(vehicleID)
+				if !paused {
+					if waited {
+						next = g.clock.Now()
+					}
+					break
+				}
+				select {
+				case <-stop:
+					close(out)
+					return
+				case <-changed:
+				}
+			}
+
+			settings := g.Settings(vehicleID)
+			deltaTime := rnd.Float64() * float64(settings.MaxTimeStep)
+			if settings.Interval > 0 {
+				deltaTime = settings.Interval.Seconds()
+			}
+			speed := rnd.Intn(settings.MaxSpeed)
+			direction := rnd.Float64() * 360
+			if settings.Immobilised {
+				speed = 0
+				direction = state.Heading
+			}
 			distance := float64(speed) * (deltaTime / 3600)
-			direction := rand.Float64() * 360
 
-			p := geo.NewPoint(latitude, longitude)
-			newPoint := p.PointAtDistanceAndBearing(distance, direction)
+			p := geo.NewPoint(state.Latitude, state.Longitude)
+			newPoint := p
+			if distance > 0 {
+				newPoint = p.PointAtDistanceAndBearing(distance, direction)
+			}
 
 			select {
 			case <-stop:
@@ -45,14 +333,35 @@ func (g *RandomTelematicsGenerator) Generate(vehicleID int, stop chan struct{})
 				return
 			case out <- models.TelematicsData{
 				VehicleID: vehicleID,
-				Timestamp: time.Now(),
+				Timestamp: next,
 				Speed:     speed,
 				Latitude:  newPoint.Lat(),
 				Longitude: newPoint.Lng(),
 			}:
-				time.Sleep(time.Duration(deltaTime) * time.Second)
-				latitude = newPoint.Lat()
-				longitude = newPoint.Lng()
+				state.Latitude = newPoint.Lat()
+				state.Longitude = newPoint.Lng()
+				state.Speed = speed
+				state.Heading = direction
+				state.Odometer += distance
+				if speed > 0 {
+					if !state.InTrip {
+						state.InTrip = true
+						state.TripStart = next
+						state.TripDistance = 0
+					}
+					state.TripDistance += distance
+				} else {
+					state.InTrip = false
+				}
+				state.RandState = src.state
+				state.UpdatedAt = next
+				g.setVehicleState(state)
+
+				next = next.Add(time.Duration(deltaTime * float64(time.Second)))
+				if !g.clock.SleepUntil(next, stop) {
+					close(out)
+					return
+				}
 			}
 		}
 	}()
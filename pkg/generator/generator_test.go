@@ -2,6 +2,9 @@ package generator
 
 import (
 	"go.uber.org/goleak"
+	"path/filepath"
+	"telematics-generator/pkg/clock"
+	"telematics-generator/pkg/models"
 	"testing"
 	"time"
 )
@@ -54,3 +57,205 @@ func TestGenerate(t *testing.T) {
 
 	goleak.VerifyNone(t)
 }
+
+func TestRestoreContinuesTrack(t *testing.T) {
+	gen := NewRandomTelematicsGenerator(100, 1)
+
+	stop := make(chan struct{})
+	telematics := gen.Generate(1, stop)
+	var last models.TelematicsData
+	for i := 0; i < 3; i++ {
+		last = <-telematics
+	}
+	close(stop)
+	for range telematics {
+	}
+
+	states := gen.Snapshot()
+	if len(states) != 1 {
+		t.Fatalf("expected 1 vehicle state, got %v", len(states))
+	}
+	if states[0].Latitude != last.Latitude || states[0].Longitude != last.Longitude {
+		t.Errorf("state position %v,%v does not match last point %v,%v",
+			states[0].Latitude, states[0].Longitude, last.Latitude, last.Longitude)
+	}
+
+	next := func() models.TelematicsData {
+		restored := NewRandomTelematicsGenerator(100, 1)
+		restored.Restore(states)
+
+		stop := make(chan struct{})
+		defer close(stop)
+
+		return <-restored.Generate(1, stop)
+	}
+
+	first, second := next(), next()
+	if first.Latitude != second.Latitude || first.Longitude != second.Longitude || first.Speed != second.Speed {
+		t.Errorf("restored generators diverged: %v and %v", first, second)
+	}
+}
+
+func TestSaveAndLoadState(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state", "generator.json")
+
+	states, err := LoadState(path)
+	if err != nil || states != nil {
+		t.Fatalf("LoadState() of missing file = %v, %v, want nil, nil", states, err)
+	}
+
+	want := []VehicleState{{
+		VehicleID: 7,
+		Latitude:  55.75,
+		Longitude: 37.61,
+		Speed:     42,
+		Heading:   90,
+		Odometer:  1234.5,
+		InTrip:    true,
+		TripStart: time.Date(2023, 7, 12, 10, 0, 0, 0, time.UTC),
+		RandState: 12345,
+	}}
+	if err := SaveState(path, want); err != nil {
+		t.Fatalf("SaveState() error = %v", err)
+	}
+
+	got, err := LoadState(path)
+	if err != nil {
+		t.Fatalf("LoadState() error = %v", err)
+	}
+	if len(got) != 1 || !got[0].TripStart.Equal(want[0].TripStart) {
+		t.Fatalf("LoadState() = %v, want %v", got, want)
+	}
+	got[0].TripStart = want[0].TripStart
+	if got[0] != want[0] {
+		t.Errorf("LoadState() = %v, want %v", got, want)
+	}
+}
+
+func TestSetMaxSpeed(t *testing.T) {
+	gen := NewRandomTelematicsGenerator(100, 0)
+	gen.SetMaxSpeed(1, 5)
+
+	stop := make(chan struct{})
+	telematics := gen.Generate(1, stop)
+	for i := 0; i < 50; i++ {
+		if data := <-telematics; data.Speed >= 5 {
+			t.Fatalf("speed %v is above the vehicle max speed 5", data.Speed)
+		}
+	}
+	close(stop)
+	for range telematics {
+	}
+}
+
+func TestPauseAndResume(t *testing.T) {
+	gen := NewRandomTelematicsGenerator(100, 0)
+
+	stop := make(chan struct{})
+	telematics := gen.Generate(1, stop)
+	<-telematics
+
+	// A record generated before the pause may still be delivered.
+	expectPaused := func(what string) {
+		t.Helper()
+		select {
+		case <-telematics:
+		case <-time.After(50 * time.Millisecond):
+		}
+		select {
+		case data := <-telematics:
+			t.Fatalf("got %v while %s", data, what)
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+	expectRunning := func(what string) {
+		t.Helper()
+		select {
+		case <-telematics:
+		case <-time.After(time.Second):
+			t.Fatalf("no records after %s", what)
+		}
+	}
+
+	gen.Pause(1)
+	if !gen.Settings(1).Paused {
+		t.Errorf("Settings().Paused = false after Pause")
+	}
+	expectPaused("the vehicle is paused")
+	gen.Resume(1)
+	expectRunning("the vehicle is resumed")
+
+	gen.PauseFleet()
+	expectPaused("the fleet is paused")
+	gen.Resume(1)
+	expectPaused("the fleet is paused and the vehicle is resumed")
+	gen.ResumeFleet()
+	expectRunning("the fleet is resumed")
+
+	gen.SetMaxSpeed(1, 20)
+	gen.SetMaxTimeStep(1, 3)
+	if settings := gen.Settings(1); settings.MaxSpeed != 20 || settings.MaxTimeStep != 3 || settings.Paused {
+		t.Errorf("Settings() = %+v, want max speed 20 and max time step 3", settings)
+	}
+	if settings := gen.Settings(2); settings.MaxSpeed != 100 || settings.MaxTimeStep != 0 {
+		t.Errorf("Settings() of another vehicle = %+v, want the generator defaults", settings)
+	}
+
+	gen.Pause(1)
+	close(stop)
+	for range telematics {
+	}
+}
+
+func TestGenerateFollowsClock(t *testing.T) {
+	simClock, _ := clock.New(time.Second, 1)
+	start := simClock.Pause().Now
+	gen := NewRandomTelematicsGenerator(100, 10, WithClock(simClock))
+
+	stop := make(chan struct{})
+	telematics := gen.Generate(1, stop)
+
+	next := func() (models.TelematicsData, bool) {
+		select {
+		case data := <-telematics:
+			return data, true
+		case <-time.After(100 * time.Millisecond):
+			return models.TelematicsData{}, false
+		}
+	}
+
+	if data, ok := next(); !ok || !data.Timestamp.Equal(start) {
+		t.Fatalf("first record = %v, want one at the clock time %v", data, start)
+	}
+	if data, ok := next(); ok {
+		t.Fatalf("got %v while the clock is paused", data)
+	}
+
+	// The vehicle catches up with the jump record by record.
+	simClock.Jump(time.Hour)
+	var records []models.TelematicsData
+	for {
+		data, ok := next()
+		if !ok {
+			break
+		}
+		records = append(records, data)
+	}
+	if len(records) < 360 {
+		t.Fatalf("got %v records after a jump of 1h with time steps up to 10s", len(records))
+	}
+	prev := start
+	for _, data := range records {
+		if data.Timestamp.Before(prev) || data.Timestamp.Sub(prev) > 10*time.Second {
+			t.Fatalf("record at %v follows one at %v", data.Timestamp, prev)
+		}
+		prev = data.Timestamp
+	}
+	if prev.After(start.Add(time.Hour)) || prev.Before(start.Add(time.Hour-10*time.Second)) {
+		t.Errorf("last record at %v, want one within 10s before %v", prev, start.Add(time.Hour))
+	}
+
+	close(stop)
+	for range telematics {
+	}
+}
@@ -0,0 +1,63 @@
+package generator
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"telematics-generator/pkg/atomicfile"
+)
+
+type VehicleState struct {
+	VehicleID    int       `json:"vehicleId"`
+	Latitude     float64   `json:"latitude"`
+	Longitude    float64   `json:"longitude"`
+	Speed        int       `json:"speed"`
+	Heading      float64   `json:"heading"`
+	Odometer     float64   `json:"odometer"`
+	InTrip       bool      `json:"inTrip"`
+	TripStart    time.Time `json:"tripStart"`
+	TripDistance float64   `json:"tripDistance"`
+	RandState    uint64    `json:"randState"`
+	UpdatedAt    time.Time `json:"updatedAt"`
+}
+
+type stateFile struct {
+	SavedAt  time.Time      `json:"savedAt"`
+	Vehicles []VehicleState `json:"vehicles"`
+}
+
+// SaveState writes the states to a temporary file and renames it over path,
+// so a crash during the write never leaves a truncated snapshot behind.
+func SaveState(path string, states []VehicleState) error {
+	data, err := json.Marshal(stateFile{
+		SavedAt:  time.Now(),
+		Vehicles: states,
+	})
+	if err != nil {
+		return err
+	}
+
+	return atomicfile.Write(path, data)
+}
+
+// LoadState returns the states saved by SaveState. A missing file is not an
+// error, it simply means there is nothing to resume.
+func LoadState(path string) ([]VehicleState, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var f stateFile
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("failed to decode state file %s: %w", path, err)
+	}
+
+	return f.Vehicles, nil
+}
@@ -0,0 +1,96 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"telematics-generator/pkg/cache"
+	"telematics-generator/pkg/track"
+	"telematics-generator/protobuf"
+)
+
+const defaultMaxGap = 10 * time.Minute
+
+// GetFleetAtTime returns the position of every vehicle matching the request
+// at the requested time, ordered by vehicle ID. Positions between two samples
+// are interpolated along the great circle; after the last sample of a vehicle
+// its last known position is returned. Vehicles without samples before the
+// requested time are omitted.
+func (s *Server) GetFleetAtTime(ctx context.Context, req *protobuf.FleetAtTimeRequest) (*protobuf.FleetAtTime, error) {
+	if req.Timestamp <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "timestamp should be positive")
+	}
+	maxGap := defaultMaxGap
+	if req.MaxGap != nil {
+		if err := req.MaxGap.CheckValid(); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if maxGap = req.MaxGap.AsDuration(); maxGap <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "max_gap should be positive")
+		}
+	}
+
+	filter, err := s.recordFilter(req.VehicleIds, req.FleetProfiles, nil, 0)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	vehicles := s.cache.ListVehicles()
+	if len(req.VehicleIds) > 0 {
+		vehicles = vehicles[:0:0]
+		seen := make(map[int]bool)
+		for _, id := range req.VehicleIds {
+			if !seen[int(id)] {
+				seen[int(id)] = true
+				vehicles = append(vehicles, int(id))
+			}
+		}
+		sort.Ints(vehicles)
+	}
+
+	t := time.Unix(0, req.Timestamp)
+	result := &protobuf.FleetAtTime{}
+	for _, vehicleID := range vehicles {
+		if err := ctx.Err(); err != nil {
+			return nil, status.FromContextError(err).Err()
+		}
+
+		b, err := s.cache.GetBracket(vehicleID, t)
+		if errors.Is(err, cache.ErrUnknownVehicle) || errors.Is(err, cache.ErrOutOfRange) {
+			continue
+		}
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if !b.HasBefore || !filter(b.Before) {
+			continue
+		}
+
+		position := &protobuf.VehiclePosition{}
+		switch {
+		case b.Before.Timestamp.Equal(t):
+			position.Position = toProto(b.Before)
+			position.Reliable = true
+			position.Gap = durationpb.New(0)
+		case b.HasAfter:
+			gap := b.After.Timestamp.Sub(b.Before.Timestamp)
+			position.Position = toProto(track.Interpolate(b.Before, b.After, t))
+			position.Interpolated = true
+			position.Reliable = gap <= maxGap
+			position.Gap = durationpb.New(gap)
+		default:
+			gap := t.Sub(b.Before.Timestamp)
+			position.Position = toProto(b.Before)
+			position.Reliable = gap <= maxGap
+			position.Gap = durationpb.New(gap)
+		}
+		result.Vehicles = append(result.Vehicles, position)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,83 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"telematics-generator/pkg/cache"
+	"telematics-generator/pkg/fleet"
+	"telematics-generator/pkg/models"
+	"telematics-generator/protobuf"
+)
+
+func TestGetFleetAtTime(t *testing.T) {
+	c := cache.NewTelematicsDataCache(100)
+	f := fleet.New([]fleet.Profile{{Name: "car", Share: 75}, {Name: "truck", Share: 25}}, 4)
+	s := NewServer(c, WithFleet(f))
+	start := time.Date(2023, 7, 12, 10, 0, 0, 0, time.UTC)
+	at := start.Add(time.Minute)
+
+	// Vehicle 1 is sampled on both sides of the requested time, vehicle 2
+	// exactly at it, vehicle 3 too rarely and vehicle 4 only after it.
+	c.Add(models.TelematicsData{VehicleID: 1, Timestamp: start, Speed: 10, Latitude: 0, Longitude: 0})
+	c.Add(models.TelematicsData{VehicleID: 1, Timestamp: start.Add(2 * time.Minute), Speed: 30, Latitude: 0, Longitude: 2})
+	c.Add(models.TelematicsData{VehicleID: 2, Timestamp: at, Speed: 40, Latitude: 1, Longitude: 1})
+	c.Add(models.TelematicsData{VehicleID: 3, Timestamp: start.Add(-time.Hour), Speed: 50})
+	c.Add(models.TelematicsData{VehicleID: 3, Timestamp: start.Add(time.Hour), Speed: 70})
+	c.Add(models.TelematicsData{VehicleID: 4, Timestamp: start.Add(time.Hour), Speed: 60})
+
+	resp, err := s.GetFleetAtTime(context.Background(), &protobuf.FleetAtTimeRequest{Timestamp: at.UnixNano()})
+	if err != nil {
+		t.Fatalf("GetFleetAtTime() error = %v", err)
+	}
+	if len(resp.Vehicles) != 3 {
+		t.Fatalf("GetFleetAtTime() = %v, want 3 vehicles", resp.Vehicles)
+	}
+
+	v1, v2, v3 := resp.Vehicles[0], resp.Vehicles[1], resp.Vehicles[2]
+	if v1.Position.VehicleId != 1 || !v1.Interpolated || !v1.Reliable || v1.Position.Speed != 20 ||
+		v1.Position.Longitude < 0.999 || v1.Position.Longitude > 1.001 || v1.Position.Timestamp != at.UnixNano() {
+		t.Errorf("GetFleetAtTime() vehicle 1 = %v, want interpolated halfway", v1)
+	}
+	if v2.Position.VehicleId != 2 || v2.Interpolated || !v2.Reliable || v2.Position.Speed != 40 {
+		t.Errorf("GetFleetAtTime() vehicle 2 = %v, want the exact sample", v2)
+	}
+	if v3.Position.VehicleId != 3 || !v3.Interpolated || v3.Reliable || v3.Gap.AsDuration() != 2*time.Hour {
+		t.Errorf("GetFleetAtTime() vehicle 3 = %v, want an unreliable interpolation over 2h", v3)
+	}
+
+	resp, err = s.GetFleetAtTime(context.Background(), &protobuf.FleetAtTimeRequest{
+		Timestamp:  start.Add(3 * time.Minute).UnixNano(),
+		MaxGap:     durationpb.New(90 * time.Second),
+		VehicleIds: []int32{2, 1, 1, 7},
+	})
+	if err != nil {
+		t.Fatalf("GetFleetAtTime() error = %v", err)
+	}
+	if len(resp.Vehicles) != 2 || resp.Vehicles[0].Position.VehicleId != 1 || resp.Vehicles[0].Interpolated ||
+		!resp.Vehicles[0].Reliable || resp.Vehicles[1].Reliable || resp.Vehicles[1].Gap.AsDuration() != 2*time.Minute {
+		t.Errorf("GetFleetAtTime() after the last samples = %v, want the last known positions of vehicles 1 and 2", resp.Vehicles)
+	}
+
+	resp, err = s.GetFleetAtTime(context.Background(), &protobuf.FleetAtTimeRequest{Timestamp: at.UnixNano(), FleetProfiles: []string{"truck"}})
+	if err != nil {
+		t.Fatalf("GetFleetAtTime() error = %v", err)
+	}
+	if len(resp.Vehicles) != 0 {
+		t.Errorf("GetFleetAtTime() of trucks = %v, want none", resp.Vehicles)
+	}
+
+	for _, req := range []*protobuf.FleetAtTimeRequest{
+		{},
+		{Timestamp: at.UnixNano(), MaxGap: durationpb.New(-time.Second)},
+		{Timestamp: at.UnixNano(), FleetProfiles: []string{"bus"}},
+	} {
+		if _, err := s.GetFleetAtTime(context.Background(), req); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("GetFleetAtTime(%v) error = %v, want InvalidArgument", req, err)
+		}
+	}
+}
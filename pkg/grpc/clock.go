@@ -0,0 +1,86 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"telematics-generator/pkg/clock"
+	"telematics-generator/protobuf"
+)
+
+const (
+	maxClockFactor = 10000
+	// maxClockJump limits a single step or jump, every vehicle generates
+	// all of its records for the skipped time.
+	maxClockJump = 24 * time.Hour
+)
+
+func (s *ControlServer) GetClock(ctx context.Context, req *emptypb.Empty) (*protobuf.ClockState, error) {
+	return clockToProto(s.controller.Clock().State()), nil
+}
+
+func (s *ControlServer) PauseClock(ctx context.Context, req *emptypb.Empty) (*protobuf.ClockState, error) {
+	return clockToProto(s.controller.Clock().Pause()), nil
+}
+
+func (s *ControlServer) ResumeClock(ctx context.Context, req *emptypb.Empty) (*protobuf.ClockState, error) {
+	return clockToProto(s.controller.Clock().Resume()), nil
+}
+
+func (s *ControlServer) SetClockSpeed(ctx context.Context, req *protobuf.SetClockSpeedRequest) (*protobuf.ClockState, error) {
+	if !(req.Factor > 0 && req.Factor <= maxClockFactor) {
+		return nil, status.Errorf(codes.InvalidArgument, "factor should be above 0 and at most %d", maxClockFactor)
+	}
+	state, err := s.controller.Clock().SetFactor(req.Factor)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return clockToProto(state), nil
+}
+
+func (s *ControlServer) StepClock(ctx context.Context, req *protobuf.StepClockRequest) (*protobuf.ClockState, error) {
+	c := s.controller.Clock()
+	if req.Ticks <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "ticks should be positive")
+	}
+	// Compared by ticks, the duration of many long ticks overflows.
+	if int64(req.Ticks) > int64(maxClockJump/c.State().Tick) {
+		return nil, status.Errorf(codes.InvalidArgument, "ticks should add up to at most %v", maxClockJump)
+	}
+	state, err := c.Step(int(req.Ticks))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return clockToProto(state), nil
+}
+
+func (s *ControlServer) JumpClock(ctx context.Context, req *protobuf.JumpClockRequest) (*protobuf.ClockState, error) {
+	if req.Duration == nil {
+		return nil, status.Error(codes.InvalidArgument, "duration is required")
+	}
+	if err := req.Duration.CheckValid(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	d := req.Duration.AsDuration()
+	if d <= 0 || d > maxClockJump {
+		return nil, status.Errorf(codes.InvalidArgument, "duration should be above 0 and at most %v", maxClockJump)
+	}
+	state, err := s.controller.Clock().Jump(d)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return clockToProto(state), nil
+}
+
+func clockToProto(state clock.State) *protobuf.ClockState {
+	return &protobuf.ClockState{
+		Now:    state.Now.UnixNano(),
+		Factor: state.Factor,
+		Paused: state.Paused,
+		Tick:   durationpb.New(state.Tick),
+	}
+}
@@ -0,0 +1,119 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"telematics-generator/pkg/clock"
+	"telematics-generator/pkg/control"
+	"telematics-generator/pkg/generator"
+	"telematics-generator/pkg/models"
+	"telematics-generator/protobuf"
+)
+
+func TestClockControl(t *testing.T) {
+	simClock, _ := clock.New(time.Second, 1)
+	gen := generator.NewRandomTelematicsGenerator(100, 10, generator.WithClock(simClock))
+	c := control.New(gen, func(models.TelematicsData) {}, 1)
+	defer c.Stop()
+	s := NewControlServer(c, nil)
+	ctx := context.Background()
+
+	paused, err := s.PauseClock(ctx, &emptypb.Empty{})
+	if err != nil || !paused.Paused || paused.Factor != 1 || paused.Tick.AsDuration() != time.Second {
+		t.Fatalf("PauseClock() = %v, %v, want a paused clock", paused, err)
+	}
+	if _, err := s.AddVehicle(ctx, &protobuf.AddVehicleRequest{VehicleId: 1}); err != nil {
+		t.Fatalf("AddVehicle() error = %v", err)
+	}
+
+	state, err := s.StepClock(ctx, &protobuf.StepClockRequest{Ticks: 5})
+	if err != nil || state.Now-paused.Now != int64(5*time.Second) {
+		t.Fatalf("StepClock() = %v, %v, want the clock 5s later", state, err)
+	}
+	state, err = s.JumpClock(ctx, &protobuf.JumpClockRequest{Duration: durationpb.New(time.Hour)})
+	if err != nil || state.Now-paused.Now != int64(time.Hour+5*time.Second) {
+		t.Fatalf("JumpClock() = %v, %v, want the clock 1h 5s later", state, err)
+	}
+
+	// The vehicle catches up with the jump.
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		v, err := s.ListVehicles(ctx, &emptypb.Empty{})
+		if err != nil {
+			t.Fatalf("ListVehicles() error = %v", err)
+		}
+		if last := v.Vehicles[0].Last; last != nil && state.Now-last.Timestamp <= int64(10*time.Second) {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("ListVehicles() = %v, want the last record within 10s of %v", v, state.Now)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	state, err = s.SetClockSpeed(ctx, &protobuf.SetClockSpeedRequest{Factor: 60})
+	if err != nil || state.Factor != 60 || !state.Paused {
+		t.Errorf("SetClockSpeed() = %v, %v, want a paused clock running 60 times faster", state, err)
+	}
+	if state, err = s.ResumeClock(ctx, &emptypb.Empty{}); err != nil || state.Paused {
+		t.Errorf("ResumeClock() = %v, %v, want a running clock", state, err)
+	}
+
+	for _, tc := range []struct {
+		name string
+		call func() error
+	}{
+		{"SetClockSpeed(0)", func() error {
+			_, err := s.SetClockSpeed(ctx, &protobuf.SetClockSpeedRequest{})
+			return err
+		}},
+		{"StepClock(0)", func() error {
+			_, err := s.StepClock(ctx, &protobuf.StepClockRequest{})
+			return err
+		}},
+		{"StepClock() by 2 days", func() error {
+			_, err := s.StepClock(ctx, &protobuf.StepClockRequest{Ticks: 2 * 24 * 3600})
+			return err
+		}},
+		{"JumpClock() backwards", func() error {
+			_, err := s.JumpClock(ctx, &protobuf.JumpClockRequest{Duration: durationpb.New(-time.Second)})
+			return err
+		}},
+		{"JumpClock() without duration", func() error {
+			_, err := s.JumpClock(ctx, &protobuf.JumpClockRequest{})
+			return err
+		}},
+	} {
+		if err := tc.call(); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("%s error = %v, want InvalidArgument", tc.name, err)
+		}
+	}
+}
+
+func TestStepClockLongTicks(t *testing.T) {
+	simClock, _ := clock.New(time.Hour, 1)
+	before := simClock.Pause().Now
+	gen := generator.NewRandomTelematicsGenerator(100, 10, generator.WithClock(simClock))
+	c := control.New(gen, func(models.TelematicsData) {}, 1)
+	defer c.Stop()
+	s := NewControlServer(c, nil)
+
+	// 3000000 ticks of 1h overflow a time.Duration.
+	if _, err := s.StepClock(context.Background(), &protobuf.StepClockRequest{Ticks: 3000000}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("StepClock() by 3000000 ticks of 1h error = %v, want InvalidArgument", err)
+	}
+	if now := simClock.Now(); !now.Equal(before) {
+		t.Errorf("clock moved from %v to %v", before, now)
+	}
+
+	state, err := s.StepClock(context.Background(), &protobuf.StepClockRequest{Ticks: 24})
+	if err != nil || state.Now-before.UnixNano() != int64(24*time.Hour) {
+		t.Errorf("StepClock() by 24 ticks of 1h = %v, %v, want the clock 24h later", state, err)
+	}
+}
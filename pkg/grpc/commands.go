@@ -0,0 +1,43 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"telematics-generator/pkg/control"
+	"telematics-generator/protobuf"
+)
+
+// SendCommand queues the command for the vehicle. Its acknowledgement is
+// published once the vehicle applies it.
+func (s *ControlServer) SendCommand(ctx context.Context, req *protobuf.CommandRequest) (*protobuf.CommandReceipt, error) {
+	cmd := control.Command{
+		ID:         req.CommandId,
+		VehicleID:  int(req.VehicleId),
+		Type:       control.CommandType(req.Type),
+		Immobilise: req.Immobilise,
+		Output:     int(req.Output),
+		OutputOn:   req.OutputOn,
+	}
+	if req.Interval != nil {
+		if err := req.Interval.CheckValid(); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		cmd.Interval = req.Interval.AsDuration()
+	}
+	if err := cmd.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	cmd, err := s.controller.SendCommand(cmd)
+	if err != nil {
+		return nil, controlError(err)
+	}
+
+	return &protobuf.CommandReceipt{
+		CommandId: cmd.ID,
+		QueuedAt:  cmd.QueuedAt.UnixNano(),
+		ApplyAt:   cmd.ApplyAt.UnixNano(),
+	}, nil
+}
@@ -0,0 +1,73 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"telematics-generator/pkg/clock"
+	"telematics-generator/pkg/control"
+	"telematics-generator/pkg/generator"
+	"telematics-generator/pkg/models"
+	"telematics-generator/protobuf"
+)
+
+func TestSendCommand(t *testing.T) {
+	simClock, _ := clock.New(time.Second, 1)
+	start := simClock.Pause().Now
+	gen := generator.NewRandomTelematicsGenerator(100, 10, generator.WithClock(simClock))
+	acks := make(chan control.Ack, 1)
+	c := control.New(gen, func(models.TelematicsData) {}, 1,
+		control.WithCommandLatency(2*time.Second), control.WithAckSink(func(ack control.Ack) { acks <- ack }))
+	defer c.Stop()
+	s := NewControlServer(c, nil)
+	ctx := context.Background()
+
+	if _, err := s.AddVehicle(ctx, &protobuf.AddVehicleRequest{VehicleId: 1}); err != nil {
+		t.Fatalf("AddVehicle() error = %v", err)
+	}
+
+	receipt, err := s.SendCommand(ctx, &protobuf.CommandRequest{
+		VehicleId: 1,
+		CommandId: "interval",
+		Type:      protobuf.CommandType_COMMAND_SET_INTERVAL,
+		Interval:  durationpb.New(30 * time.Second),
+	})
+	if err != nil {
+		t.Fatalf("SendCommand() error = %v", err)
+	}
+	if receipt.CommandId != "interval" || receipt.QueuedAt != start.UnixNano() || receipt.ApplyAt != start.Add(2*time.Second).UnixNano() {
+		t.Errorf("SendCommand() = %v, want the command applied 2s after %v", receipt, start)
+	}
+
+	s.StepClock(ctx, &protobuf.StepClockRequest{Ticks: 2})
+	select {
+	case ack := <-acks:
+		if !ack.Applied || ack.Command.ID != "interval" {
+			t.Errorf("ack = %+v, want the command applied", ack)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("no ack after the latency passed")
+	}
+	list, err := s.ListVehicles(ctx, nil)
+	if err != nil || list.Vehicles[0].Interval.AsDuration() != 30*time.Second {
+		t.Errorf("ListVehicles() = %v, %v, want the interval of 30s", list, err)
+	}
+
+	for _, tc := range []struct {
+		req  *protobuf.CommandRequest
+		code codes.Code
+	}{
+		{&protobuf.CommandRequest{VehicleId: 2, Type: protobuf.CommandType_COMMAND_REQUEST_POSITION}, codes.NotFound},
+		{&protobuf.CommandRequest{VehicleId: 1, Type: protobuf.CommandType_COMMAND_SET_OUTPUT, Output: control.Outputs}, codes.InvalidArgument},
+		{&protobuf.CommandRequest{VehicleId: 1, Interval: durationpb.New(time.Millisecond)}, codes.InvalidArgument},
+		{&protobuf.CommandRequest{VehicleId: 1, Type: 42}, codes.InvalidArgument},
+	} {
+		if _, err := s.SendCommand(ctx, tc.req); status.Code(err) != tc.code {
+			t.Errorf("SendCommand(%v) error = %v, want %v", tc.req, err, tc.code)
+		}
+	}
+}
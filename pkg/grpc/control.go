@@ -0,0 +1,169 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"telematics-generator/pkg/control"
+	"telematics-generator/pkg/fleet"
+	"telematics-generator/protobuf"
+)
+
+const maxTimeStepLimit = 24 * time.Hour
+
+// ControlServer serves the control service of the simulation.
+type ControlServer struct {
+	controller *control.Controller
+	fleet      *fleet.Fleet
+	protobuf.UnimplementedControlServiceServer
+}
+
+// NewControlServer returns the control service of the controller. The fleet
+// is optional and only used to report the profiles of the vehicles.
+func NewControlServer(c *control.Controller, f *fleet.Fleet) *ControlServer {
+	return &ControlServer{controller: c, fleet: f}
+}
+
+func (s *ControlServer) AddVehicle(ctx context.Context, req *protobuf.AddVehicleRequest) (*protobuf.VehicleStatus, error) {
+	if req.VehicleId < 0 {
+		return nil, status.Error(codes.InvalidArgument, "vehicle_id should not be negative")
+	}
+	maxSpeed, maxTimeStep, err := parseTuning(req.MaxSpeed, req.MaxTimeStep)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	vehicleID, err := s.controller.Add(int(req.VehicleId), maxSpeed, maxTimeStep)
+	if err != nil {
+		return nil, controlError(err)
+	}
+
+	return s.vehicleStatus(vehicleID)
+}
+
+func (s *ControlServer) RemoveVehicle(ctx context.Context, req *protobuf.VehicleRequest) (*emptypb.Empty, error) {
+	if err := s.controller.Remove(int(req.VehicleId)); err != nil {
+		return nil, controlError(err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *ControlServer) PauseVehicle(ctx context.Context, req *protobuf.VehicleRequest) (*protobuf.VehicleStatus, error) {
+	if err := s.controller.Pause(int(req.VehicleId)); err != nil {
+		return nil, controlError(err)
+	}
+	return s.vehicleStatus(int(req.VehicleId))
+}
+
+func (s *ControlServer) ResumeVehicle(ctx context.Context, req *protobuf.VehicleRequest) (*protobuf.VehicleStatus, error) {
+	if err := s.controller.Resume(int(req.VehicleId)); err != nil {
+		return nil, controlError(err)
+	}
+	return s.vehicleStatus(int(req.VehicleId))
+}
+
+func (s *ControlServer) PauseFleet(ctx context.Context, req *emptypb.Empty) (*protobuf.VehicleList, error) {
+	s.controller.PauseFleet()
+	return s.ListVehicles(ctx, req)
+}
+
+func (s *ControlServer) ResumeFleet(ctx context.Context, req *emptypb.Empty) (*protobuf.VehicleList, error) {
+	s.controller.ResumeFleet()
+	return s.ListVehicles(ctx, req)
+}
+
+func (s *ControlServer) TuneVehicle(ctx context.Context, req *protobuf.TuneVehicleRequest) (*protobuf.VehicleStatus, error) {
+	maxSpeed, maxTimeStep, err := parseTuning(req.MaxSpeed, req.MaxTimeStep)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := s.controller.Tune(int(req.VehicleId), maxSpeed, maxTimeStep); err != nil {
+		return nil, controlError(err)
+	}
+	return s.vehicleStatus(int(req.VehicleId))
+}
+
+// ListVehicles returns the running vehicles ordered by vehicle ID.
+func (s *ControlServer) ListVehicles(ctx context.Context, req *emptypb.Empty) (*protobuf.VehicleList, error) {
+	list := &protobuf.VehicleList{FleetPaused: s.controller.FleetPaused()}
+	for _, v := range s.controller.Vehicles() {
+		list.Vehicles = append(list.Vehicles, s.statusToProto(v))
+	}
+	return list, nil
+}
+
+func (s *ControlServer) vehicleStatus(vehicleID int) (*protobuf.VehicleStatus, error) {
+	v, err := s.controller.Status(vehicleID)
+	if err != nil {
+		return nil, controlError(err)
+	}
+	return s.statusToProto(v), nil
+}
+
+func (s *ControlServer) statusToProto(v control.VehicleStatus) *protobuf.VehicleStatus {
+	st := &protobuf.VehicleStatus{
+		VehicleId:   int32(v.VehicleID),
+		Paused:      v.Settings.Paused,
+		MaxSpeed:    int32(v.Settings.MaxSpeed),
+		MaxTimeStep: durationpb.New(time.Duration(v.Settings.MaxTimeStep) * time.Second),
+		Interval:    durationpb.New(v.Settings.Interval),
+		Immobilised: v.Settings.Immobilised,
+		Outputs:     v.Settings.Outputs,
+	}
+	if s.fleet != nil {
+		if profile, ok := s.fleet.ProfileOf(v.VehicleID); ok {
+			st.FleetProfile = profile.Name
+		}
+	}
+	if v.HasState && !v.State.UpdatedAt.IsZero() {
+		st.Last = &protobuf.TelematicsDataProto{
+			VehicleId: int32(v.VehicleID),
+			Timestamp: v.State.UpdatedAt.UnixNano(),
+			Speed:     int32(v.State.Speed),
+			Latitude:  v.State.Latitude,
+			Longitude: v.State.Longitude,
+		}
+		st.OdometerKm = v.State.Odometer
+		st.InTrip = v.State.InTrip
+	}
+	return st
+}
+
+// parseTuning returns the max speed and the max time step in seconds, zero
+// if not set.
+func parseTuning(maxSpeed int32, maxTimeStep *durationpb.Duration) (int, int, error) {
+	if maxSpeed < 0 {
+		return 0, 0, errors.New("max_speed should not be negative")
+	}
+	if maxTimeStep == nil {
+		return int(maxSpeed), 0, nil
+	}
+	if err := maxTimeStep.CheckValid(); err != nil {
+		return 0, 0, err
+	}
+	step := maxTimeStep.AsDuration()
+	if step < time.Second || step > maxTimeStepLimit {
+		return 0, 0, errors.New("max_time_step should be from 1s to 24h")
+	}
+	return int(maxSpeed), int(step / time.Second), nil
+}
+
+func controlError(err error) error {
+	switch {
+	case errors.Is(err, control.ErrUnknownVehicle):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, control.ErrVehicleExists):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, control.ErrTooManyVehicles), errors.Is(err, control.ErrCommandQueueFull):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	case errors.Is(err, control.ErrStopped):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
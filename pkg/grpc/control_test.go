@@ -0,0 +1,106 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"telematics-generator/pkg/control"
+	"telematics-generator/pkg/fleet"
+	"telematics-generator/pkg/generator"
+	"telematics-generator/pkg/models"
+	"telematics-generator/protobuf"
+)
+
+func TestControlServer(t *testing.T) {
+	gen := generator.NewRandomTelematicsGenerator(100, 0)
+	c := control.New(gen, func(models.TelematicsData) {}, 3)
+	defer c.Stop()
+	f := fleet.New([]fleet.Profile{{Name: "car", Share: 100}}, 1)
+	s := NewControlServer(c, f)
+	ctx := context.Background()
+
+	v, err := s.AddVehicle(ctx, &protobuf.AddVehicleRequest{})
+	if err != nil {
+		t.Fatalf("AddVehicle() error = %v", err)
+	}
+	if v.VehicleId != 1 || v.FleetProfile != "car" || v.MaxSpeed != 100 || v.Paused {
+		t.Errorf("AddVehicle() = %v, want running vehicle 1", v)
+	}
+
+	v, err = s.AddVehicle(ctx, &protobuf.AddVehicleRequest{VehicleId: 7, MaxSpeed: 30, MaxTimeStep: durationpb.New(2 * time.Second)})
+	if err != nil {
+		t.Fatalf("AddVehicle() error = %v", err)
+	}
+	if v.VehicleId != 7 || v.MaxSpeed != 30 || v.MaxTimeStep.AsDuration() != 2*time.Second || v.FleetProfile != "car" {
+		t.Errorf("AddVehicle() = %v, want vehicle 7 tuned with the first profile", v)
+	}
+
+	v, err = s.TuneVehicle(ctx, &protobuf.TuneVehicleRequest{VehicleId: 7, MaxSpeed: 50})
+	if err != nil {
+		t.Fatalf("TuneVehicle() error = %v", err)
+	}
+	if v.MaxSpeed != 50 || v.MaxTimeStep.AsDuration() != 2*time.Second {
+		t.Errorf("TuneVehicle() = %v, want max speed 50 and the max time step kept", v)
+	}
+
+	if v, err = s.PauseVehicle(ctx, &protobuf.VehicleRequest{VehicleId: 1}); err != nil || !v.Paused {
+		t.Errorf("PauseVehicle() = %v, %v, want a paused vehicle", v, err)
+	}
+	if v, err = s.ResumeVehicle(ctx, &protobuf.VehicleRequest{VehicleId: 1}); err != nil || v.Paused {
+		t.Errorf("ResumeVehicle() = %v, %v, want a running vehicle", v, err)
+	}
+
+	list, err := s.PauseFleet(ctx, &emptypb.Empty{})
+	if err != nil || !list.FleetPaused || len(list.Vehicles) != 2 {
+		t.Errorf("PauseFleet() = %v, %v, want 2 vehicles of a paused fleet", list, err)
+	}
+	list, err = s.ResumeFleet(ctx, &emptypb.Empty{})
+	if err != nil || list.FleetPaused {
+		t.Errorf("ResumeFleet() = %v, %v, want a running fleet", list, err)
+	}
+
+	if _, err := s.RemoveVehicle(ctx, &protobuf.VehicleRequest{VehicleId: 7}); err != nil {
+		t.Fatalf("RemoveVehicle() error = %v", err)
+	}
+	time.Sleep(20 * time.Millisecond)
+	list, err = s.ListVehicles(ctx, &emptypb.Empty{})
+	if err != nil || len(list.Vehicles) != 1 || list.Vehicles[0].VehicleId != 1 || list.Vehicles[0].Last == nil {
+		t.Errorf("ListVehicles() = %v, %v, want vehicle 1 with its last record", list, err)
+	}
+
+	for _, tc := range []struct {
+		call func() error
+		code codes.Code
+	}{
+		{func() error { _, err := s.AddVehicle(ctx, &protobuf.AddVehicleRequest{VehicleId: 1}); return err }, codes.AlreadyExists},
+		{func() error { _, err := s.AddVehicle(ctx, &protobuf.AddVehicleRequest{VehicleId: -1}); return err }, codes.InvalidArgument},
+		{func() error {
+			_, err := s.AddVehicle(ctx, &protobuf.AddVehicleRequest{MaxTimeStep: durationpb.New(time.Millisecond)})
+			return err
+		}, codes.InvalidArgument},
+		{func() error {
+			_, err := s.TuneVehicle(ctx, &protobuf.TuneVehicleRequest{VehicleId: 1, MaxSpeed: -1})
+			return err
+		}, codes.InvalidArgument},
+		{func() error { _, err := s.PauseVehicle(ctx, &protobuf.VehicleRequest{VehicleId: 7}); return err }, codes.NotFound},
+		{func() error { _, err := s.RemoveVehicle(ctx, &protobuf.VehicleRequest{VehicleId: 7}); return err }, codes.NotFound},
+	} {
+		if err := tc.call(); status.Code(err) != tc.code {
+			t.Errorf("error = %v, want %v", err, tc.code)
+		}
+	}
+
+	for _, id := range []int32{2, 3} {
+		if _, err := s.AddVehicle(ctx, &protobuf.AddVehicleRequest{VehicleId: id}); err != nil {
+			t.Fatalf("AddVehicle() error = %v", err)
+		}
+	}
+	if _, err := s.AddVehicle(ctx, &protobuf.AddVehicleRequest{}); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("AddVehicle() above the limit error = %v, want ResourceExhausted", err)
+	}
+}
@@ -0,0 +1,85 @@
+package grpc
+
+import (
+	"log"
+	"time"
+
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// HealthCheck returns why a dependency of the services is unhealthy, nil if
+// it is healthy.
+type HealthCheck func() error
+
+// Health serves the grpc.health.v1 Health service. The server as a whole and
+// the given services are SERVING while all checks pass and NOT_SERVING
+// otherwise, and for good after Shutdown.
+type Health struct {
+	server   *health.Server
+	services []string
+	checks   []HealthCheck
+	err      error
+}
+
+func NewHealth(services []string, checks ...HealthCheck) *Health {
+	h := &Health{server: health.NewServer(), services: services, checks: checks}
+	h.Check()
+	return h
+}
+
+// Server returns the Health service to register.
+func (h *Health) Server() healthpb.HealthServer {
+	return h.server
+}
+
+// Check runs the checks and updates the serving status. Only Run calls it
+// after NewHealth.
+func (h *Health) Check() error {
+	var err error
+	for _, check := range h.checks {
+		if err = check(); err != nil {
+			break
+		}
+	}
+
+	status := healthpb.HealthCheckResponse_SERVING
+	if err != nil {
+		status = healthpb.HealthCheckResponse_NOT_SERVING
+	}
+	if (err == nil) != (h.err == nil) {
+		if err != nil {
+			log.Printf("Services are not serving: %v", err)
+		} else {
+			log.Println("Services are serving")
+		}
+	}
+	h.err = err
+
+	h.server.SetServingStatus("", status)
+	for _, service := range h.services {
+		h.server.SetServingStatus(service, status)
+	}
+	return err
+}
+
+// Run calls Check every interval until stop is closed.
+func (h *Health) Run(interval time.Duration, stop chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			h.Check()
+		}
+	}
+}
+
+// Shutdown makes every service NOT_SERVING, so that clients and load
+// balancers stop sending requests while the server shuts down.
+func (h *Health) Shutdown() {
+	h.server.Shutdown()
+}
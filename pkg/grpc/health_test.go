@@ -0,0 +1,45 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestHealth(t *testing.T) {
+	var kafkaErr error
+	h := NewHealth([]string{"proto.TelematicsDataService"}, func() error { return kafkaErr })
+	ctx := context.Background()
+
+	expect := func(service string, want healthpb.HealthCheckResponse_ServingStatus) {
+		t.Helper()
+		resp, err := h.Server().Check(ctx, &healthpb.HealthCheckRequest{Service: service})
+		if err != nil {
+			t.Fatalf("Check(%q) error = %v", service, err)
+		}
+		if resp.Status != want {
+			t.Errorf("Check(%q) = %v, want %v", service, resp.Status, want)
+		}
+	}
+
+	expect("", healthpb.HealthCheckResponse_SERVING)
+	expect("proto.TelematicsDataService", healthpb.HealthCheckResponse_SERVING)
+
+	kafkaErr = errors.New("kafka is down")
+	if err := h.Check(); err != kafkaErr {
+		t.Errorf("Check() error = %v, want %v", err, kafkaErr)
+	}
+	expect("", healthpb.HealthCheckResponse_NOT_SERVING)
+	expect("proto.TelematicsDataService", healthpb.HealthCheckResponse_NOT_SERVING)
+
+	kafkaErr = nil
+	h.Check()
+	expect("proto.TelematicsDataService", healthpb.HealthCheckResponse_SERVING)
+
+	h.Shutdown()
+	h.Check()
+	expect("", healthpb.HealthCheckResponse_NOT_SERVING)
+	expect("proto.TelematicsDataService", healthpb.HealthCheckResponse_NOT_SERVING)
+}
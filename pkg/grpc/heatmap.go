@@ -0,0 +1,97 @@
+package grpc
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"telematics-generator/pkg/cache"
+	"telematics-generator/pkg/heatmap"
+	"telematics-generator/pkg/models"
+	"telematics-generator/protobuf"
+)
+
+const (
+	defaultGeohashPrecision = 6
+	defaultCellSize         = 1000
+)
+
+// GetHeatmap returns the number of records and their speeds in every cell of
+// the requested grid. Records within a bounding box are taken from the
+// spatial index of the cache.
+func (s *Server) GetHeatmap(ctx context.Context, req *protobuf.HeatmapRequest) (*protobuf.Heatmap, error) {
+	if req.ToTimestamp < req.FromTimestamp {
+		return nil, status.Error(codes.InvalidArgument, "to_timestamp should not be earlier than from_timestamp")
+	}
+	grid, err := gridFromProto(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	filter, err := s.recordFilter(nil, req.FleetProfiles, nil, 0)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	from := time.Unix(0, req.FromTimestamp)
+	to := time.Unix(0, req.ToTimestamp)
+	var data []models.TelematicsData
+	if req.BoundingBox != nil {
+		box, err := boxFromProto(req.BoundingBox)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		data, err = s.cache.GetInArea(box, from, to, cache.ExcludeBoth)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	} else {
+		data, err = s.cache.GetRange(from, to)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	filtered := data[:0]
+	for _, d := range data {
+		if filter(d) {
+			filtered = append(filtered, d)
+		}
+	}
+
+	resp := &protobuf.Heatmap{Total: int32(len(filtered))}
+	for _, b := range heatmap.Aggregate(filtered, grid) {
+		resp.Cells = append(resp.Cells, &protobuf.HeatmapCell{
+			CellId:   b.ID,
+			Center:   &protobuf.GeoPoint{Latitude: b.Latitude, Longitude: b.Longitude},
+			Count:    int32(b.Count),
+			AvgSpeed: b.AvgSpeed,
+			MaxSpeed: int32(b.MaxSpeed),
+		})
+	}
+
+	return resp, nil
+}
+
+func gridFromProto(req *protobuf.HeatmapRequest) (heatmap.Grid, error) {
+	size := req.CellSizeMeters
+	if size == 0 {
+		size = defaultCellSize
+	}
+
+	switch req.Grid {
+	case protobuf.GridType_GRID_GEOHASH:
+		precision := int(req.GeohashPrecision)
+		if precision == 0 {
+			precision = defaultGeohashPrecision
+		}
+		return heatmap.Geohash(precision)
+	case protobuf.GridType_GRID_SQUARE:
+		return heatmap.Squares(size)
+	case protobuf.GridType_GRID_HEXAGON:
+		return heatmap.Hexagons(size)
+	default:
+		return nil, fmt.Errorf("unsupported grid %v", req.Grid)
+	}
+}
@@ -0,0 +1,72 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"telematics-generator/pkg/cache"
+	"telematics-generator/pkg/fleet"
+	"telematics-generator/pkg/models"
+	"telematics-generator/protobuf"
+)
+
+func TestGetHeatmap(t *testing.T) {
+	c := cache.NewTelematicsDataCache(100)
+	f := fleet.New([]fleet.Profile{{Name: "car", Share: 50}, {Name: "truck", Share: 50}}, 4)
+	s := NewServer(c, WithFleet(f))
+	start := time.Date(2023, 7, 12, 10, 0, 0, 0, time.UTC)
+
+	// Cars 1 and 2 and truck 3 in Kyiv, truck 4 in Lviv.
+	for i := 0; i < 3; i++ {
+		ts := start.Add(time.Duration(i) * time.Second)
+		c.Add(models.TelematicsData{VehicleID: 1, Timestamp: ts, Speed: 10, Latitude: 50.45, Longitude: 30.515})
+		c.Add(models.TelematicsData{VehicleID: 2, Timestamp: ts, Speed: 20, Latitude: 50.4501, Longitude: 30.5151})
+		c.Add(models.TelematicsData{VehicleID: 3, Timestamp: ts, Speed: 30, Latitude: 50.4502, Longitude: 30.515})
+		c.Add(models.TelematicsData{VehicleID: 4, Timestamp: ts, Speed: 40, Latitude: 49.84, Longitude: 24.03})
+	}
+
+	from, to := start.Add(-time.Second).UnixNano(), start.Add(time.Minute).UnixNano()
+	for _, grid := range []protobuf.GridType{protobuf.GridType_GRID_GEOHASH, protobuf.GridType_GRID_SQUARE, protobuf.GridType_GRID_HEXAGON} {
+		resp, err := s.GetHeatmap(context.Background(), &protobuf.HeatmapRequest{FromTimestamp: from, ToTimestamp: to, Grid: grid})
+		if err != nil {
+			t.Fatalf("GetHeatmap(%v) error = %v", grid, err)
+		}
+		if resp.Total != 12 || len(resp.Cells) != 2 || resp.Cells[0].Count != 9 || resp.Cells[0].AvgSpeed != 20 ||
+			resp.Cells[0].MaxSpeed != 30 || resp.Cells[1].Count != 3 {
+			t.Errorf("GetHeatmap(%v) = %v, want 9 records in Kyiv and 3 in Lviv", grid, resp)
+		}
+	}
+
+	resp, err := s.GetHeatmap(context.Background(), &protobuf.HeatmapRequest{
+		FromTimestamp:    from,
+		ToTimestamp:      to,
+		Grid:             protobuf.GridType_GRID_GEOHASH,
+		GeohashPrecision: 9,
+		FleetProfiles:    []string{"truck"},
+		BoundingBox: &protobuf.BoundingBox{
+			Min: &protobuf.GeoPoint{Latitude: 50, Longitude: 30},
+			Max: &protobuf.GeoPoint{Latitude: 51, Longitude: 31},
+		},
+	})
+	if err != nil {
+		t.Fatalf("GetHeatmap() error = %v", err)
+	}
+	if resp.Total != 3 || len(resp.Cells) != 1 || len(resp.Cells[0].CellId) != 9 || resp.Cells[0].AvgSpeed != 30 {
+		t.Errorf("GetHeatmap() of trucks in Kyiv = %v, want 3 records of truck 3", resp)
+	}
+
+	for _, req := range []*protobuf.HeatmapRequest{
+		{FromTimestamp: to, ToTimestamp: from},
+		{FromTimestamp: from, ToTimestamp: to, Grid: 7},
+		{FromTimestamp: from, ToTimestamp: to, GeohashPrecision: 13},
+		{FromTimestamp: from, ToTimestamp: to, Grid: protobuf.GridType_GRID_HEXAGON, CellSizeMeters: -5},
+		{FromTimestamp: from, ToTimestamp: to, FleetProfiles: []string{"bus"}},
+	} {
+		if _, err := s.GetHeatmap(context.Background(), req); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("GetHeatmap(%v) error = %v, want InvalidArgument", req, err)
+		}
+	}
+}
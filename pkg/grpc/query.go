@@ -0,0 +1,419 @@
+package grpc
+
+import (
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"hash/fnv"
+	"math"
+	"sort"
+	"strings"
+	"telematics-generator/pkg/cache"
+	"telematics-generator/pkg/models"
+	"telematics-generator/pkg/track"
+	"telematics-generator/protobuf"
+	"time"
+)
+
+// NextPageTokenKey is the trailer holding the page token of the next page of
+// a range query.
+const NextPageTokenKey = "next-page-token"
+
+// maxResamplePoints limits the points a resampled track of one vehicle can
+// have, so a tiny interval cannot exhaust the memory of the server.
+const maxResamplePoints = 10_000
+
+// rangeQuery is a validated RangeDataRequest.
+type rangeQuery struct {
+	from       time.Time
+	to         time.Time
+	resolution time.Duration
+	vehicleIDs []int
+	limit      int
+	ascending  bool
+	fields     map[string]bool
+	token      *pageToken
+	resample   time.Duration
+	tolerance  float64
+}
+
+// pageToken points right after the last record sent: at the timestamp of the
+// record, after skip records with that timestamp. Query is a hash of the
+// request it belongs to.
+type pageToken struct {
+	query     uint64
+	timestamp int64
+	skip      int
+}
+
+// page is the result of a range query in the requested order, keyed by the
+// timestamp the ordering and page tokens are based on.
+type page struct {
+	records []*protobuf.TelematicsDataProto
+	keys    []int64
+}
+
+func (s *Server) GetRangeData(req *protobuf.RangeDataRequest, srv protobuf.TelematicsDataService_GetRangeDataServer) error {
+	q, err := parseRangeQuery(req)
+	if err != nil {
+		return err
+	}
+
+	var result page
+	if q.resolution != 0 {
+		result, err = s.queryRollups(q, req.Resolution)
+	} else {
+		result, err = s.queryRecords(q)
+	}
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	start := 0
+	if q.token != nil {
+		start = result.after(q.token, q.ascending)
+	}
+	end := len(result.records)
+	if q.limit > 0 && end-start > q.limit {
+		end = start + q.limit
+		next := result.tokenAt(end-1, hashRangeQuery(req))
+		srv.SetTrailer(metadata.Pairs(NextPageTokenKey, next.encode()))
+	}
+
+	for _, d := range result.records[start:end] {
+		if q.fields != nil {
+			project(d, q.fields)
+		}
+		if err := srv.Send(d); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func parseRangeQuery(req *protobuf.RangeDataRequest) (*rangeQuery, error) {
+	q := &rangeQuery{
+		from:      time.Unix(0, req.FromTimestamp),
+		to:        time.Unix(0, req.ToTimestamp),
+		limit:     int(req.Limit),
+		ascending: req.Order == protobuf.Order_ORDER_ASC,
+	}
+
+	var violations []*errdetails.BadRequest_FieldViolation
+	violate := func(field, description string) {
+		violations = append(violations, &errdetails.BadRequest_FieldViolation{Field: field, Description: description})
+	}
+
+	if req.ToTimestamp < req.FromTimestamp {
+		violate("to_timestamp", "to_timestamp should not be earlier than from_timestamp")
+	}
+
+	switch req.Resolution {
+	case protobuf.Resolution_RESOLUTION_RAW:
+	case protobuf.Resolution_RESOLUTION_MINUTE:
+		q.resolution = cache.MinuteResolution
+	case protobuf.Resolution_RESOLUTION_HOUR:
+		q.resolution = cache.HourResolution
+	default:
+		violate("resolution", fmt.Sprintf("unsupported resolution %v", req.Resolution))
+	}
+
+	seen := make(map[int]bool)
+	for _, id := range req.VehicleIds {
+		if !seen[int(id)] {
+			seen[int(id)] = true
+			q.vehicleIDs = append(q.vehicleIDs, int(id))
+		}
+	}
+	sort.Ints(q.vehicleIDs)
+
+	if req.Limit < 0 {
+		violate("limit", "limit should not be negative")
+	}
+
+	if req.Order != protobuf.Order_ORDER_DESC && req.Order != protobuf.Order_ORDER_ASC {
+		violate("order", fmt.Sprintf("unsupported order %v", req.Order))
+	}
+
+	if req.ResampleInterval != nil {
+		if err := req.ResampleInterval.CheckValid(); err != nil {
+			violate("resample_interval", err.Error())
+		} else if q.resample = req.ResampleInterval.AsDuration(); q.resample <= 0 {
+			violate("resample_interval", "resample_interval should be positive")
+		} else if req.ToTimestamp >= req.FromTimestamp &&
+			uint64(req.ToTimestamp-req.FromTimestamp)/uint64(q.resample) > maxResamplePoints {
+			violate("resample_interval", fmt.Sprintf("resample_interval should split the range into at most %d points", maxResamplePoints))
+		}
+	}
+
+	if req.SimplifyToleranceMeters < 0 || math.IsNaN(req.SimplifyToleranceMeters) || math.IsInf(req.SimplifyToleranceMeters, 0) {
+		violate("simplify_tolerance_meters", "simplify_tolerance_meters should be a non-negative number")
+	} else {
+		q.tolerance = req.SimplifyToleranceMeters
+	}
+
+	if req.ResampleInterval != nil && req.SimplifyToleranceMeters != 0 {
+		violate("simplify_tolerance_meters", "simplify_tolerance_meters cannot be combined with resample_interval")
+	}
+	if (req.ResampleInterval != nil || req.SimplifyToleranceMeters != 0) && req.Resolution != protobuf.Resolution_RESOLUTION_RAW {
+		violate("resolution", "track processing is supported for raw records only")
+	}
+
+	if len(req.GetFields().GetPaths()) > 0 {
+		q.fields = make(map[string]bool)
+		fields := (&protobuf.TelematicsDataProto{}).ProtoReflect().Descriptor().Fields()
+		for _, path := range req.GetFields().GetPaths() {
+			if fields.ByName(protoreflect.Name(path)) == nil || strings.Contains(path, ".") {
+				violate("fields", fmt.Sprintf("%q is not a top-level field of TelematicsDataProto", path))
+				continue
+			}
+			q.fields[path] = true
+		}
+	}
+
+	if req.PageToken != "" {
+		token, err := decodePageToken(req.PageToken)
+		if err != nil {
+			violate("page_token", err.Error())
+		} else if token.query != hashRangeQuery(req) {
+			violate("page_token", "page_token belongs to a different query")
+		} else {
+			q.token = token
+		}
+	}
+
+	if len(violations) > 0 {
+		st, err := status.New(codes.InvalidArgument, "invalid range request").
+			WithDetails(&errdetails.BadRequest{FieldViolations: violations})
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, violations[0].Description)
+		}
+		return nil, st.Err()
+	}
+
+	return q, nil
+}
+
+// queryRecords returns raw records newest first, records with the same
+// timestamp in the order of the cache, or by vehicle if vehicles are given or
+// the tracks are processed.
+func (s *Server) queryRecords(q *rangeQuery) (page, error) {
+	// Processed tracks depend on the whole range, so pages of them are cut
+	// from the result instead of narrowing the range.
+	processed := q.resample > 0 || q.tolerance > 0
+	from, to, bounds := q.from, q.to, cache.ExcludeBoth
+	if q.token != nil && q.ascending && !processed {
+		from, bounds = time.Unix(0, q.token.timestamp), cache.IncludeFrom
+	}
+	if q.token != nil && !q.ascending && !processed {
+		to, bounds = time.Unix(0, q.token.timestamp), cache.IncludeTo
+	}
+
+	// A range out of the cached records is an error whatever the filters,
+	// unless the previous page ended at the edge of the records.
+	if err := s.cache.CheckRange(from, to); err != nil {
+		if q.token != nil && errors.Is(err, cache.ErrOutOfRange) {
+			return page{}, nil
+		}
+		return page{}, err
+	}
+
+	var data []models.TelematicsData
+	if len(q.vehicleIDs) == 0 {
+		var err error
+		data, err = s.cache.GetRangeBounds(from, to, bounds)
+		if err != nil {
+			return page{}, err
+		}
+	} else {
+		for _, vehicleID := range q.vehicleIDs {
+			records, err := s.cache.GetRangeForVehicle(vehicleID, from, to, bounds)
+			if err != nil && !errors.Is(err, cache.ErrUnknownVehicle) && !errors.Is(err, cache.ErrOutOfRange) {
+				return page{}, err
+			}
+			data = append(data, records...)
+		}
+		sort.SliceStable(data, func(i, j int) bool {
+			return data[i].Timestamp.After(data[j].Timestamp)
+		})
+	}
+	if processed {
+		data = processTracks(data, q)
+	}
+
+	result := page{
+		records: make([]*protobuf.TelematicsDataProto, 0, len(data)),
+		keys:    make([]int64, 0, len(data)),
+	}
+	for _, d := range data {
+		result.records = append(result.records, toProto(d))
+		result.keys = append(result.keys, d.Timestamp.UnixNano())
+	}
+	if q.ascending {
+		result.reverse()
+	}
+
+	return result, nil
+}
+
+// processTracks resamples or simplifies the track of every vehicle in the
+// records sorted newest first and returns the resulting records newest first,
+// records with the same timestamp by vehicle.
+func processTracks(data []models.TelematicsData, q *rangeQuery) []models.TelematicsData {
+	tracks := make(map[int][]models.TelematicsData)
+	for i := len(data) - 1; i >= 0; i-- {
+		tracks[data[i].VehicleID] = append(tracks[data[i].VehicleID], data[i])
+	}
+
+	var result []models.TelematicsData
+	for _, t := range tracks {
+		if q.resample > 0 {
+			result = append(result, track.Resample(t, q.resample)...)
+		} else {
+			result = append(result, track.Simplify(t, q.tolerance)...)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if !result[i].Timestamp.Equal(result[j].Timestamp) {
+			return result[i].Timestamp.After(result[j].Timestamp)
+		}
+		return result[i].VehicleID < result[j].VehicleID
+	})
+
+	return result
+}
+
+// queryRollups returns the rollups newest first, keyed by the bucket start.
+func (s *Server) queryRollups(q *rangeQuery, resolution protobuf.Resolution) (page, error) {
+	rollups, err := s.cache.GetRollups(q.resolution, q.from, q.to, q.vehicleIDs...)
+	if err != nil {
+		return page{}, err
+	}
+
+	var result page
+	for _, r := range rollups {
+		d := toProto(r.Last)
+		d.Rollup = &protobuf.Rollup{
+			BucketStart:    r.Start.UnixNano(),
+			Resolution:     resolution,
+			Count:          int32(r.Count),
+			MinSpeed:       int32(r.MinSpeed),
+			AvgSpeed:       r.AvgSpeed,
+			MaxSpeed:       int32(r.MaxSpeed),
+			DistanceMeters: r.Distance,
+		}
+		result.records = append(result.records, d)
+		result.keys = append(result.keys, r.Start.UnixNano())
+	}
+	if q.ascending {
+		result.reverse()
+	}
+
+	return result, nil
+}
+
+func (p *page) reverse() {
+	for i, j := 0, len(p.records)-1; i < j; i, j = i+1, j-1 {
+		p.records[i], p.records[j] = p.records[j], p.records[i]
+		p.keys[i], p.keys[j] = p.keys[j], p.keys[i]
+	}
+}
+
+// after returns the index of the first record after the token.
+func (p *page) after(token *pageToken, ascending bool) int {
+	i := sort.Search(len(p.keys), func(i int) bool {
+		if ascending {
+			return p.keys[i] >= token.timestamp
+		}
+		return p.keys[i] <= token.timestamp
+	})
+	for skipped := 0; i < len(p.keys) && p.keys[i] == token.timestamp && skipped < token.skip; skipped++ {
+		i++
+	}
+	return i
+}
+
+// tokenAt returns the token pointing after the record at index i. The page
+// always starts with the records skipped by the previous token, so they are
+// counted as well.
+func (p *page) tokenAt(i int, query uint64) *pageToken {
+	token := &pageToken{query: query, timestamp: p.keys[i]}
+	for j := i; j >= 0 && p.keys[j] == token.timestamp; j-- {
+		token.skip++
+	}
+	return token
+}
+
+func (t *pageToken) encode() string {
+	buf := binary.AppendUvarint(nil, t.query)
+	buf = binary.AppendVarint(buf, t.timestamp)
+	buf = binary.AppendUvarint(buf, uint64(t.skip))
+	return base64.RawURLEncoding.EncodeToString(buf)
+}
+
+func decodePageToken(s string) (*pageToken, error) {
+	invalid := errors.New("page_token is malformed")
+
+	buf, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, invalid
+	}
+
+	var t pageToken
+	var n int
+	if t.query, n = binary.Uvarint(buf); n <= 0 {
+		return nil, invalid
+	}
+	buf = buf[n:]
+	if t.timestamp, n = binary.Varint(buf); n <= 0 {
+		return nil, invalid
+	}
+	buf = buf[n:]
+	skip, n := binary.Uvarint(buf)
+	if n <= 0 || n != len(buf) {
+		return nil, invalid
+	}
+	t.skip = int(skip)
+
+	return &t, nil
+}
+
+// hashRangeQuery hashes the fields of the request a page token depends on.
+func hashRangeQuery(req *protobuf.RangeDataRequest) uint64 {
+	h := fnv.New64a()
+	buf := binary.AppendVarint(nil, req.FromTimestamp)
+	buf = binary.AppendVarint(buf, req.ToTimestamp)
+	buf = binary.AppendVarint(buf, int64(req.Resolution))
+	buf = binary.AppendVarint(buf, int64(req.Order))
+	buf = binary.AppendVarint(buf, int64(req.GetResampleInterval().AsDuration()))
+	buf = binary.AppendUvarint(buf, math.Float64bits(req.SimplifyToleranceMeters))
+
+	ids := append([]int32(nil), req.VehicleIds...)
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	for i, id := range ids {
+		if i == 0 || id != ids[i-1] {
+			buf = binary.AppendVarint(buf, int64(id))
+		}
+	}
+
+	h.Write(buf)
+	return h.Sum64()
+}
+
+// project clears the fields of the record not listed in fields.
+func project(d *protobuf.TelematicsDataProto, fields map[string]bool) {
+	m := d.ProtoReflect()
+	m.Range(func(fd protoreflect.FieldDescriptor, _ protoreflect.Value) bool {
+		if !fields[string(fd.Name())] {
+			m.Clear(fd)
+		}
+		return true
+	})
+}
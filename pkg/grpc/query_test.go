@@ -0,0 +1,309 @@
+package grpc
+
+import (
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"math"
+	"telematics-generator/pkg/cache"
+	"telematics-generator/pkg/models"
+	"telematics-generator/protobuf"
+	"testing"
+	"time"
+)
+
+func TestGetRangeDataPages(t *testing.T) {
+	c := cache.NewTelematicsDataCache(100)
+	s := NewServer(c)
+	now := time.Now()
+
+	for i := 0; i < 10; i++ {
+		c.Add(models.TelematicsData{VehicleID: i % 3, Timestamp: now.Add(time.Duration(i/4) * time.Second), Speed: i})
+	}
+
+	for _, order := range []protobuf.Order{protobuf.Order_ORDER_DESC, protobuf.Order_ORDER_ASC} {
+		req := &protobuf.RangeDataRequest{
+			FromTimestamp: now.Add(-time.Minute).UnixNano(),
+			ToTimestamp:   now.Add(time.Minute).UnixNano(),
+			Limit:         3,
+			Order:         order,
+		}
+		all := newMockTelematicsDataService_GetRangeDataServer()
+		if err := s.GetRangeData(&protobuf.RangeDataRequest{
+			FromTimestamp: req.FromTimestamp,
+			ToTimestamp:   req.ToTimestamp,
+			Order:         order,
+		}, all); err != nil {
+			t.Fatalf("GetRangeData() error = %v", err)
+		}
+
+		var paged []*protobuf.TelematicsDataProto
+		for pages := 0; ; pages++ {
+			if pages > 4 {
+				t.Fatalf("too many pages")
+			}
+			stream := newMockTelematicsDataService_GetRangeDataServer()
+			if err := s.GetRangeData(req, stream); err != nil {
+				t.Fatalf("GetRangeData() error = %v", err)
+			}
+			if len(stream.responses) > 3 {
+				t.Fatalf("GetRangeData() sent %v records, limit is 3", len(stream.responses))
+			}
+			paged = append(paged, stream.responses...)
+
+			tokens := stream.trailer.Get(NextPageTokenKey)
+			if len(tokens) == 0 {
+				break
+			}
+			req.PageToken = tokens[0]
+		}
+
+		if len(paged) != 10 || len(all.responses) != 10 {
+			t.Fatalf("%v: got %v records in pages and %v at once, want 10", order, len(paged), len(all.responses))
+		}
+		for i := range paged {
+			if paged[i].Speed != all.responses[i].Speed {
+				t.Errorf("%v: record %d of pages = %v, want %v", order, i, paged[i], all.responses[i])
+			}
+		}
+		if order == protobuf.Order_ORDER_ASC && (paged[0].Speed != 0 || paged[9].Speed != 9) {
+			t.Errorf("ascending order starts with %v and ends with %v", paged[0], paged[9])
+		}
+	}
+}
+
+func TestGetRangeDataFilterAndProjection(t *testing.T) {
+	c := cache.NewTelematicsDataCache(100)
+	s := NewServer(c)
+	now := time.Now()
+
+	for i := 0; i < 9; i++ {
+		c.Add(models.TelematicsData{VehicleID: i % 3, Timestamp: now.Add(time.Duration(i) * time.Second), Speed: i, Latitude: 50})
+	}
+
+	stream := newMockTelematicsDataService_GetRangeDataServer()
+	err := s.GetRangeData(&protobuf.RangeDataRequest{
+		FromTimestamp: now.Add(-time.Minute).UnixNano(),
+		ToTimestamp:   now.Add(time.Minute).UnixNano(),
+		VehicleIds:    []int32{2, 0, 2, 5},
+		Fields:        &fieldmaskpb.FieldMask{Paths: []string{"vehicle_id", "speed"}},
+	}, stream)
+	if err != nil {
+		t.Fatalf("GetRangeData() error = %v", err)
+	}
+
+	want := []int32{8, 6, 5, 3, 2, 0}
+	if len(stream.responses) != len(want) {
+		t.Fatalf("GetRangeData() = %v, want records of vehicles 0 and 2", stream.responses)
+	}
+	for i, speed := range want {
+		d := stream.responses[i]
+		if d.Speed != speed || d.Timestamp != 0 || d.Latitude != 0 {
+			t.Errorf("GetRangeData()[%d] = %v, want speed %v and no other fields than vehicle_id", i, d, speed)
+		}
+	}
+}
+
+func TestGetRangeDataOutOfRange(t *testing.T) {
+	c := cache.NewTelematicsDataCache(100)
+	s := NewServer(c)
+	now := time.Now()
+
+	for i := 0; i < 4; i++ {
+		c.Add(models.TelematicsData{VehicleID: i % 2, Timestamp: now.Add(time.Duration(i) * time.Second)})
+	}
+
+	past := now.Add(-time.Hour)
+	requests := map[string]*protobuf.RangeDataRequest{
+		"no filter":      {},
+		"vehicle filter": {VehicleIds: []int32{1}},
+		"projection":     {Fields: &fieldmaskpb.FieldMask{Paths: []string{"speed"}}},
+		"tracks":         {VehicleIds: []int32{0, 1}, ResampleInterval: durationpb.New(time.Second)},
+	}
+	for name, req := range requests {
+		req.FromTimestamp = past.UnixNano()
+		req.ToTimestamp = past.Add(time.Minute).UnixNano()
+		err := s.GetRangeData(req, newMockTelematicsDataService_GetRangeDataServer())
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("GetRangeData() with %v out of the cached range error = %v, want InvalidArgument", name, err)
+		}
+	}
+
+	// A vehicle without records in a cached range is not an error.
+	stream := newMockTelematicsDataService_GetRangeDataServer()
+	err := s.GetRangeData(&protobuf.RangeDataRequest{
+		FromTimestamp: now.UnixNano(),
+		ToTimestamp:   now.Add(time.Minute).UnixNano(),
+		VehicleIds:    []int32{7},
+	}, stream)
+	if err != nil || len(stream.responses) != 0 {
+		t.Errorf("GetRangeData() of an unknown vehicle = %v, %v, want no records", stream.responses, err)
+	}
+}
+
+func TestGetRangeDataTracks(t *testing.T) {
+	c := cache.NewTelematicsDataCache(100)
+	s := NewServer(c)
+	start := time.Date(2023, 7, 12, 10, 0, 0, 0, time.UTC)
+
+	// Both vehicles go east along the equator, vehicle 2 with a turn north
+	// in the middle.
+	for i := 0; i <= 6; i++ {
+		ts := start.Add(time.Duration(i)*10*time.Second + time.Second)
+		c.Add(models.TelematicsData{VehicleID: 1, Timestamp: ts, Speed: 10 * i, Longitude: 0.001 * float64(i)})
+		lat := 0.0
+		if i == 3 {
+			lat = 0.001
+		}
+		c.Add(models.TelematicsData{VehicleID: 2, Timestamp: ts, Speed: 10 * i, Latitude: lat, Longitude: 0.001 * float64(i)})
+	}
+
+	req := &protobuf.RangeDataRequest{
+		FromTimestamp:    start.UnixNano(),
+		ToTimestamp:      start.Add(time.Hour).UnixNano(),
+		Order:            protobuf.Order_ORDER_ASC,
+		ResampleInterval: durationpb.New(15 * time.Second),
+	}
+	all := newMockTelematicsDataService_GetRangeDataServer()
+	if err := s.GetRangeData(req, all); err != nil {
+		t.Fatalf("GetRangeData() error = %v", err)
+	}
+	if len(all.responses) != 8 {
+		t.Fatalf("GetRangeData() resampled = %v, want 4 records of each vehicle", all.responses)
+	}
+	// Ascending order reverses the descending one, vehicles included.
+	for i, d := range all.responses {
+		want := start.Add(time.Duration(i/2+1) * 15 * time.Second)
+		if d.Timestamp != want.UnixNano() || d.VehicleId != int32(2-i%2) || d.Speed != int32(15*(i/2+1)-1) {
+			t.Errorf("GetRangeData() resampled record %d = %v, want vehicle %d at %v", i, d, 2-i%2, want)
+		}
+	}
+
+	req.Limit = 3
+	var paged []*protobuf.TelematicsDataProto
+	for pages := 0; pages < 4; pages++ {
+		stream := newMockTelematicsDataService_GetRangeDataServer()
+		if err := s.GetRangeData(req, stream); err != nil {
+			t.Fatalf("GetRangeData() error = %v", err)
+		}
+		paged = append(paged, stream.responses...)
+		tokens := stream.trailer.Get(NextPageTokenKey)
+		if len(tokens) == 0 {
+			break
+		}
+		req.PageToken = tokens[0]
+	}
+	if len(paged) != len(all.responses) {
+		t.Fatalf("GetRangeData() resampled in pages = %v, want %v", paged, all.responses)
+	}
+	for i := range paged {
+		if paged[i].Timestamp != all.responses[i].Timestamp || paged[i].VehicleId != all.responses[i].VehicleId {
+			t.Errorf("record %d of pages = %v, want %v", i, paged[i], all.responses[i])
+		}
+	}
+
+	stream := newMockTelematicsDataService_GetRangeDataServer()
+	err := s.GetRangeData(&protobuf.RangeDataRequest{
+		FromTimestamp:           start.UnixNano(),
+		ToTimestamp:             start.Add(time.Hour).UnixNano(),
+		SimplifyToleranceMeters: 10,
+	}, stream)
+	if err != nil {
+		t.Fatalf("GetRangeData() error = %v", err)
+	}
+	// Records are identified by vehicle and speed as 100*vehicle+speed.
+	var got []int32
+	for _, d := range stream.responses {
+		got = append(got, d.VehicleId*100+d.Speed)
+	}
+	want := []int32{160, 260, 240, 230, 220, 100, 200}
+	if len(got) != len(want) {
+		t.Fatalf("GetRangeData() simplified = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("GetRangeData() simplified = %v, want %v", got, want)
+			break
+		}
+	}
+
+	err = s.GetRangeData(&protobuf.RangeDataRequest{
+		ToTimestamp:             start.UnixNano(),
+		Resolution:              protobuf.Resolution_RESOLUTION_MINUTE,
+		SimplifyToleranceMeters: 10,
+	}, newMockTelematicsDataService_GetRangeDataServer())
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("GetRangeData() simplifying rollups error = %v, want InvalidArgument", err)
+	}
+}
+
+func TestGetRangeDataValidation(t *testing.T) {
+	s := NewServer(cache.NewTelematicsDataCache(10))
+	now := time.Now()
+
+	req := &protobuf.RangeDataRequest{
+		FromTimestamp:           now.UnixNano(),
+		ToTimestamp:             now.Add(-time.Second).UnixNano(),
+		Limit:                   -1,
+		Order:                   7,
+		ResampleInterval:        durationpb.New(-time.Second),
+		SimplifyToleranceMeters: -1,
+		Fields:                  &fieldmaskpb.FieldMask{Paths: []string{"speed", "rollup.count", "heading"}},
+		PageToken:               "not a token",
+	}
+	err := s.GetRangeData(req, newMockTelematicsDataService_GetRangeDataServer())
+	if status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("GetRangeData() error = %v, want InvalidArgument", err)
+	}
+
+	var fields []string
+	for _, detail := range status.Convert(err).Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			for _, v := range badRequest.FieldViolations {
+				fields = append(fields, v.Field)
+			}
+		}
+	}
+	want := []string{"to_timestamp", "limit", "order", "resample_interval", "simplify_tolerance_meters",
+		"simplify_tolerance_meters", "fields", "fields", "page_token"}
+	if len(fields) != len(want) {
+		t.Fatalf("field violations = %v, want %v", fields, want)
+	}
+	for i := range want {
+		if fields[i] != want[i] {
+			t.Errorf("field violation %d = %v, want %v", i, fields[i], want[i])
+		}
+	}
+
+	token := (&pageToken{query: 1, timestamp: now.UnixNano(), skip: 1}).encode()
+	err = s.GetRangeData(&protobuf.RangeDataRequest{ToTimestamp: now.UnixNano(), PageToken: token}, newMockTelematicsDataService_GetRangeDataServer())
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("GetRangeData() with a token of another query error = %v, want InvalidArgument", err)
+	}
+
+	resampled := func(from, to int64, interval time.Duration) bool {
+		err := s.GetRangeData(&protobuf.RangeDataRequest{
+			FromTimestamp:    from,
+			ToTimestamp:      to,
+			ResampleInterval: durationpb.New(interval),
+		}, newMockTelematicsDataService_GetRangeDataServer())
+		for _, detail := range status.Convert(err).Details() {
+			if badRequest, ok := detail.(*errdetails.BadRequest); ok && badRequest.FieldViolations[0].Field == "resample_interval" {
+				return false
+			}
+		}
+		return true
+	}
+	hour := now.Add(-time.Hour).UnixNano()
+	if resampled(hour, now.UnixNano(), time.Nanosecond) || resampled(hour, now.UnixNano(), time.Hour/maxResamplePoints/2) {
+		t.Errorf("GetRangeData() resampling 1h into more than %d points is accepted", maxResamplePoints)
+	}
+	if resampled(math.MinInt64, math.MaxInt64, time.Hour) {
+		t.Errorf("GetRangeData() resampling the whole time range is accepted")
+	}
+	if !resampled(hour, now.UnixNano(), time.Hour/maxResamplePoints) {
+		t.Errorf("GetRangeData() resampling 1h into %d points is rejected", maxResamplePoints)
+	}
+}
@@ -2,21 +2,67 @@ package grpc
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"sort"
 	"telematics-generator/pkg/cache"
+	"telematics-generator/pkg/clock"
+	"telematics-generator/pkg/fleet"
+	"telematics-generator/pkg/models"
+	"telematics-generator/pkg/pubsub"
+	"telematics-generator/pkg/track"
 	"telematics-generator/protobuf"
 	"time"
 )
 
 type Server struct {
-	cache cache.DataCacher
+	cache       cache.DataCacher
+	hub         *pubsub.Hub
+	fleet       *fleet.Fleet
+	tripOptions track.TripOptions
+	clock       *clock.Clock
 	protobuf.UnimplementedTelematicsDataServiceServer
 }
 
-func NewServer(c cache.DataCacher) *Server {
-	return &Server{cache: c}
+type Option func(*Server)
+
+// WithHub enables live subscriptions to the records published to the hub.
+func WithHub(hub *pubsub.Hub) Option {
+	return func(s *Server) {
+		s.hub = hub
+	}
+}
+
+// WithFleet enables filtering by fleet profile.
+func WithFleet(f *fleet.Fleet) Option {
+	return func(s *Server) {
+		s.fleet = f
+	}
+}
+
+// WithClock makes the server tell the current time by the simulation clock.
+func WithClock(c *clock.Clock) Option {
+	return func(s *Server) {
+		s.clock = c
+	}
+}
+
+func NewServer(c cache.DataCacher, opts ...Option) *Server {
+	s := &Server{cache: c, tripOptions: track.DefaultTripOptions}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+func (s *Server) now() time.Time {
+	if s.clock != nil {
+		return s.clock.Now()
+	}
+	return time.Now()
 }
 
 func (s *Server) GetLatestData(ctx context.Context, req *emptypb.Empty) (*protobuf.TelematicsDataProto, error) {
@@ -25,32 +71,69 @@ func (s *Server) GetLatestData(ctx context.Context, req *emptypb.Empty) (*protob
 		return nil, status.Error(codes.NotFound, "no data available")
 	}
 
-	return &protobuf.TelematicsDataProto{
-		VehicleId: int32(data.VehicleID),
-		Timestamp: data.Timestamp.UnixNano(),
-		Speed:     int32(data.Speed),
-		Latitude:  data.Latitude,
-		Longitude: data.Longitude,
-	}, nil
+	return toProto(data), nil
 }
 
-func (s *Server) GetRangeData(req *protobuf.RangeDataRequest, srv protobuf.TelematicsDataService_GetRangeDataServer) error {
+// GetFleetSnapshot returns the latest record of every vehicle matching the
+// request, ordered by vehicle ID.
+func (s *Server) GetFleetSnapshot(ctx context.Context, req *protobuf.FleetSnapshotRequest) (*protobuf.FleetSnapshot, error) {
+	filter, err := s.recordFilter(req.VehicleIds, req.FleetProfiles, req.BoundingBox, req.MinSpeed)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var latest []models.TelematicsData
+	if len(req.VehicleIds) > 0 {
+		for _, vehicleID := range req.VehicleIds {
+			if data, ok := s.cache.GetLatestForVehicle(int(vehicleID)); ok {
+				latest = append(latest, data)
+			}
+		}
+		sort.Slice(latest, func(i, j int) bool {
+			return latest[i].VehicleID < latest[j].VehicleID
+		})
+	} else {
+		latest = s.cache.GetLatestPerVehicle()
+	}
+
+	snapshot := &protobuf.FleetSnapshot{}
+	for i, data := range latest {
+		if i > 0 && data.VehicleID == latest[i-1].VehicleID {
+			continue
+		}
+		if filter(data) {
+			snapshot.Vehicles = append(snapshot.Vehicles, toProto(data))
+		}
+	}
+
+	return snapshot, nil
+}
+
+func (s *Server) GetVehicleLatest(ctx context.Context, req *protobuf.VehicleLatestRequest) (*protobuf.TelematicsDataProto, error) {
+	data, ok := s.cache.GetLatestForVehicle(int(req.VehicleId))
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "no data available for vehicle %d", req.VehicleId)
+	}
+
+	return toProto(data), nil
+}
+
+func (s *Server) GetAreaData(req *protobuf.AreaDataRequest, srv protobuf.TelematicsDataService_GetAreaDataServer) error {
+	area, err := areaFromProto(req)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
 	from := time.Unix(0, req.FromTimestamp)
 	to := time.Unix(0, req.ToTimestamp)
 
-	data, err := s.cache.GetRange(from, to)
+	data, err := s.cache.GetInArea(area, from, to, cache.ExcludeBoth)
 	if err != nil {
 		return status.Error(codes.InvalidArgument, err.Error())
 	}
 
 	for _, d := range data {
-		err := srv.Send(&protobuf.TelematicsDataProto{
-			VehicleId: int32(d.VehicleID),
-			Timestamp: d.Timestamp.UnixNano(),
-			Speed:     int32(d.Speed),
-			Latitude:  d.Latitude,
-			Longitude: d.Longitude,
-		})
+		err := srv.Send(toProto(d))
 		if err != nil {
 			return err
 		}
@@ -58,3 +141,109 @@ func (s *Server) GetRangeData(req *protobuf.RangeDataRequest, srv protobuf.Telem
 
 	return nil
 }
+
+func areaFromProto(req *protobuf.AreaDataRequest) (cache.Area, error) {
+	switch area := req.Area.(type) {
+	case *protobuf.AreaDataRequest_BoundingBox:
+		return boxFromProto(area.BoundingBox)
+	case *protobuf.AreaDataRequest_Polygon:
+		polygon := make(cache.Polygon, 0, len(area.Polygon.GetPoints()))
+		for _, p := range area.Polygon.GetPoints() {
+			polygon = append(polygon, pointFromProto(p))
+		}
+		return polygon, polygon.Validate()
+	case *protobuf.AreaDataRequest_Circle:
+		if area.Circle.GetCenter() == nil {
+			return nil, errors.New("circle center is required")
+		}
+		circle := cache.Circle{
+			Center: pointFromProto(area.Circle.GetCenter()),
+			Radius: area.Circle.GetRadiusMeters(),
+		}
+		return circle, circle.Validate()
+	default:
+		return nil, errors.New("one of bounding_box, polygon or circle is required")
+	}
+}
+
+// recordFilter returns a filter matching the records that pass all the given
+// conditions. Empty conditions match every record.
+func (s *Server) recordFilter(vehicleIDs []int32, fleetProfiles []string, boundingBox *protobuf.BoundingBox, minSpeed int32) (func(models.TelematicsData) bool, error) {
+	var vehicles map[int]bool
+	if len(vehicleIDs) > 0 {
+		vehicles = make(map[int]bool)
+		for _, id := range vehicleIDs {
+			vehicles[int(id)] = true
+		}
+	}
+
+	var profiles map[string]bool
+	if len(fleetProfiles) > 0 {
+		if s.fleet == nil {
+			return nil, fmt.Errorf("fleet profiles are not configured")
+		}
+		profiles = make(map[string]bool)
+		for _, name := range fleetProfiles {
+			if _, ok := s.fleet.Profile(name); !ok {
+				return nil, fmt.Errorf("unknown fleet profile %s", name)
+			}
+			profiles[name] = true
+		}
+	}
+
+	var box *cache.BoundingBox
+	if boundingBox != nil {
+		b, err := boxFromProto(boundingBox)
+		if err != nil {
+			return nil, err
+		}
+		box = &b
+	}
+
+	if minSpeed < 0 {
+		return nil, fmt.Errorf("min_speed should not be negative")
+	}
+
+	return func(d models.TelematicsData) bool {
+		if vehicles != nil && !vehicles[d.VehicleID] {
+			return false
+		}
+		if profiles != nil {
+			profile, ok := s.fleet.ProfileOf(d.VehicleID)
+			if !ok || !profiles[profile.Name] {
+				return false
+			}
+		}
+		if box != nil && !box.Contains(cache.Point{Latitude: d.Latitude, Longitude: d.Longitude}) {
+			return false
+		}
+		return d.Speed >= int(minSpeed)
+	}, nil
+}
+
+func boxFromProto(b *protobuf.BoundingBox) (cache.BoundingBox, error) {
+	if b.GetMin() == nil || b.GetMax() == nil {
+		return cache.BoundingBox{}, errors.New("bounding box min and max are required")
+	}
+	box := cache.BoundingBox{
+		MinLatitude:  b.GetMin().GetLatitude(),
+		MinLongitude: b.GetMin().GetLongitude(),
+		MaxLatitude:  b.GetMax().GetLatitude(),
+		MaxLongitude: b.GetMax().GetLongitude(),
+	}
+	return box, box.Validate()
+}
+
+func pointFromProto(p *protobuf.GeoPoint) cache.Point {
+	return cache.Point{Latitude: p.GetLatitude(), Longitude: p.GetLongitude()}
+}
+
+func toProto(data models.TelematicsData) *protobuf.TelematicsDataProto {
+	return &protobuf.TelematicsDataProto{
+		VehicleId: int32(data.VehicleID),
+		Timestamp: data.Timestamp.UnixNano(),
+		Speed:     int32(data.Speed),
+		Latitude:  data.Latitude,
+		Longitude: data.Longitude,
+	}
+}
@@ -3,8 +3,12 @@ package grpc
 import (
 	"context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"telematics-generator/pkg/cache"
+	"telematics-generator/pkg/fleet"
 	"telematics-generator/pkg/models"
 	"telematics-generator/protobuf"
 	"testing"
@@ -79,13 +83,164 @@ func TestGetRangeData(t *testing.T) {
 type mockTelematicsDataService_GetRangeDataServer struct {
 	grpc.ServerStream
 	responses []*protobuf.TelematicsDataProto
+	trailer   metadata.MD
 }
 
 func newMockTelematicsDataService_GetRangeDataServer() *mockTelematicsDataService_GetRangeDataServer {
 	return &mockTelematicsDataService_GetRangeDataServer{}
 }
 
+func (m *mockTelematicsDataService_GetRangeDataServer) SetTrailer(md metadata.MD) {
+	m.trailer = metadata.Join(m.trailer, md)
+}
+
 func (m *mockTelematicsDataService_GetRangeDataServer) Send(resp *protobuf.TelematicsDataProto) error {
 	m.responses = append(m.responses, resp)
 	return nil
 }
+
+func TestGetAreaData(t *testing.T) {
+	c := cache.NewTelematicsDataCache(10)
+	s := NewServer(c)
+	now := time.Now()
+
+	c.Add(models.TelematicsData{VehicleID: 1, Timestamp: now, Latitude: 50.4500, Longitude: 30.5233})
+	c.Add(models.TelematicsData{VehicleID: 2, Timestamp: now, Latitude: 55.7558, Longitude: 37.6173})
+
+	req := &protobuf.AreaDataRequest{
+		FromTimestamp: now.Add(-time.Minute).UnixNano(),
+		ToTimestamp:   now.Add(time.Minute).UnixNano(),
+		Area: &protobuf.AreaDataRequest_Circle{Circle: &protobuf.Circle{
+			Center:       &protobuf.GeoPoint{Latitude: 50.45, Longitude: 30.52},
+			RadiusMeters: 1000,
+		}},
+	}
+	stream := newMockTelematicsDataService_GetRangeDataServer()
+	if err := s.GetAreaData(req, stream); err != nil {
+		t.Fatalf("GetAreaData() error = %v", err)
+	}
+	if len(stream.responses) != 1 || stream.responses[0].VehicleId != 1 {
+		t.Errorf("GetAreaData() = %v, want the record of vehicle 1", stream.responses)
+	}
+
+	req.Area = &protobuf.AreaDataRequest_BoundingBox{BoundingBox: &protobuf.BoundingBox{
+		Min: &protobuf.GeoPoint{Latitude: 50, Longitude: 30},
+		Max: &protobuf.GeoPoint{Latitude: 56, Longitude: 38},
+	}}
+	stream = newMockTelematicsDataService_GetRangeDataServer()
+	if err := s.GetAreaData(req, stream); err != nil {
+		t.Fatalf("GetAreaData() error = %v", err)
+	}
+	if len(stream.responses) != 2 {
+		t.Errorf("GetAreaData() expected 2 responses, got %v", len(stream.responses))
+	}
+
+	req.Area = &protobuf.AreaDataRequest_Polygon{Polygon: &protobuf.Polygon{}}
+	err := s.GetAreaData(req, newMockTelematicsDataService_GetRangeDataServer())
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("GetAreaData() with an empty polygon error = %v, want InvalidArgument", err)
+	}
+}
+
+func TestGetRangeDataRollups(t *testing.T) {
+	c := cache.NewTelematicsDataCache(10)
+	s := NewServer(c)
+	start := time.Now().Truncate(time.Hour)
+
+	c.Add(models.TelematicsData{VehicleID: 1, Timestamp: start.Add(time.Second), Speed: 10})
+	c.Add(models.TelematicsData{VehicleID: 1, Timestamp: start.Add(2 * time.Second), Speed: 30})
+	c.Add(models.TelematicsData{VehicleID: 1, Timestamp: start.Add(time.Minute), Speed: 20})
+
+	req := &protobuf.RangeDataRequest{
+		FromTimestamp: start.UnixNano(),
+		ToTimestamp:   start.Add(time.Hour).UnixNano(),
+		Resolution:    protobuf.Resolution_RESOLUTION_MINUTE,
+	}
+	stream := newMockTelematicsDataService_GetRangeDataServer()
+	if err := s.GetRangeData(req, stream); err != nil {
+		t.Fatalf("GetRangeData() error = %v", err)
+	}
+	if len(stream.responses) != 2 {
+		t.Fatalf("GetRangeData() expected 2 minute buckets, got %v", len(stream.responses))
+	}
+	first := stream.responses[1]
+	if first.Rollup.GetBucketStart() != start.UnixNano() || first.Rollup.GetCount() != 2 ||
+		first.Rollup.GetAvgSpeed() != 20 || first.Speed != 30 {
+		t.Errorf("GetRangeData() first minute = %v", first)
+	}
+
+	req.Resolution = protobuf.Resolution_RESOLUTION_HOUR
+	stream = newMockTelematicsDataService_GetRangeDataServer()
+	if err := s.GetRangeData(req, stream); err != nil {
+		t.Fatalf("GetRangeData() error = %v", err)
+	}
+	if len(stream.responses) != 1 || stream.responses[0].Rollup.GetCount() != 3 {
+		t.Errorf("GetRangeData() = %v, want a single hour bucket of 3 records", stream.responses)
+	}
+
+	req.Resolution = 42
+	err := s.GetRangeData(req, newMockTelematicsDataService_GetRangeDataServer())
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("GetRangeData() with an unknown resolution error = %v, want InvalidArgument", err)
+	}
+}
+
+func TestGetFleetSnapshot(t *testing.T) {
+	c := cache.NewTelematicsDataCache(10)
+	f := fleet.New([]fleet.Profile{{Name: "car", Share: 50}, {Name: "truck", Share: 50}}, 4)
+	s := NewServer(c, WithFleet(f))
+	now := time.Now()
+
+	for vehicleID := 1; vehicleID <= 4; vehicleID++ {
+		c.Add(models.TelematicsData{VehicleID: vehicleID, Timestamp: now.Add(-time.Second), Speed: 10 * vehicleID})
+		c.Add(models.TelematicsData{VehicleID: vehicleID, Timestamp: now, Speed: 20 * vehicleID})
+	}
+
+	snapshot, err := s.GetFleetSnapshot(context.Background(), &protobuf.FleetSnapshotRequest{})
+	if err != nil {
+		t.Fatalf("GetFleetSnapshot() error = %v", err)
+	}
+	if len(snapshot.Vehicles) != 4 || snapshot.Vehicles[3].VehicleId != 4 || snapshot.Vehicles[3].Speed != 80 {
+		t.Errorf("GetFleetSnapshot() = %v, want the latest record of 4 vehicles", snapshot.Vehicles)
+	}
+
+	snapshot, err = s.GetFleetSnapshot(context.Background(), &protobuf.FleetSnapshotRequest{
+		VehicleIds:    []int32{4, 1, 3, 3, 7},
+		FleetProfiles: []string{"truck"},
+		MinSpeed:      70,
+	})
+	if err != nil {
+		t.Fatalf("GetFleetSnapshot() error = %v", err)
+	}
+	if len(snapshot.Vehicles) != 1 || snapshot.Vehicles[0].VehicleId != 4 {
+		t.Errorf("GetFleetSnapshot() = %v, want vehicle 4 only", snapshot.Vehicles)
+	}
+
+	_, err = s.GetFleetSnapshot(context.Background(), &protobuf.FleetSnapshotRequest{FleetProfiles: []string{"bus"}})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("GetFleetSnapshot() with an unknown profile error = %v, want InvalidArgument", err)
+	}
+}
+
+func TestGetVehicleLatest(t *testing.T) {
+	c := cache.NewTelematicsDataCache(10)
+	s := NewServer(c)
+	now := time.Now()
+
+	c.Add(models.TelematicsData{VehicleID: 1, Timestamp: now, Speed: 10})
+	c.Add(models.TelematicsData{VehicleID: 1, Timestamp: now.Add(-time.Second), Speed: 20})
+	c.Add(models.TelematicsData{VehicleID: 2, Timestamp: now, Speed: 30})
+
+	resp, err := s.GetVehicleLatest(context.Background(), &protobuf.VehicleLatestRequest{VehicleId: 1})
+	if err != nil {
+		t.Fatalf("GetVehicleLatest() error = %v", err)
+	}
+	if resp.VehicleId != 1 || resp.Speed != 10 {
+		t.Errorf("GetVehicleLatest() = %v, want the record with the latest timestamp", resp)
+	}
+
+	_, err = s.GetVehicleLatest(context.Background(), &protobuf.VehicleLatestRequest{VehicleId: 3})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("GetVehicleLatest() of an unknown vehicle error = %v, want NotFound", err)
+	}
+}
@@ -0,0 +1,102 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"telematics-generator/pkg/cache"
+	"telematics-generator/pkg/models"
+	"telematics-generator/pkg/track"
+	"telematics-generator/protobuf"
+)
+
+// GetVehicleStats returns the stats of every vehicle with records in the
+// requested range, ordered by vehicle ID, and their sum.
+func (s *Server) GetVehicleStats(ctx context.Context, req *protobuf.VehicleStatsRequest) (*protobuf.VehicleStatsResponse, error) {
+	if req.ToTimestamp < req.FromTimestamp {
+		return nil, status.Error(codes.InvalidArgument, "to_timestamp should not be earlier than from_timestamp")
+	}
+	if req.IdleSpeed < 0 {
+		return nil, status.Error(codes.InvalidArgument, "idle_speed should not be negative")
+	}
+
+	tracks, err := s.vehicleTracks(time.Unix(0, req.FromTimestamp), time.Unix(0, req.ToTimestamp), req.VehicleIds)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var fleet track.Stats
+	resp := &protobuf.VehicleStatsResponse{}
+	for _, t := range tracks {
+		stats := track.Summarize(t, int(req.IdleSpeed))
+		fleet.Merge(stats)
+		resp.Vehicles = append(resp.Vehicles, statsToProto(stats))
+	}
+	resp.Fleet = statsToProto(fleet)
+
+	return resp, nil
+}
+
+// vehicleTracks returns the records of the given vehicles, or of all vehicles
+// if none are given, between from and to, excluding both. Every track holds
+// the records of one vehicle sorted by timestamp ascending and the tracks are
+// ordered by vehicle ID.
+func (s *Server) vehicleTracks(from, to time.Time, vehicleIDs []int32) ([][]models.TelematicsData, error) {
+	var data []models.TelematicsData
+	if len(vehicleIDs) == 0 {
+		var err error
+		data, err = s.cache.GetRange(from, to)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		seen := make(map[int]bool)
+		for _, id := range vehicleIDs {
+			if seen[int(id)] {
+				continue
+			}
+			seen[int(id)] = true
+			records, err := s.cache.GetRangeForVehicle(int(id), from, to, cache.ExcludeBoth)
+			if err != nil && !errors.Is(err, cache.ErrUnknownVehicle) && !errors.Is(err, cache.ErrOutOfRange) {
+				return nil, err
+			}
+			data = append(data, records...)
+		}
+	}
+
+	byVehicle := make(map[int][]models.TelematicsData)
+	for i := len(data) - 1; i >= 0; i-- {
+		byVehicle[data[i].VehicleID] = append(byVehicle[data[i].VehicleID], data[i])
+	}
+
+	tracks := make([][]models.TelematicsData, 0, len(byVehicle))
+	for _, t := range byVehicle {
+		sort.SliceStable(t, func(i, j int) bool {
+			return t[i].Timestamp.Before(t[j].Timestamp)
+		})
+		tracks = append(tracks, t)
+	}
+	sort.Slice(tracks, func(i, j int) bool {
+		return tracks[i][0].VehicleID < tracks[j][0].VehicleID
+	})
+
+	return tracks, nil
+}
+
+func statsToProto(stats track.Stats) *protobuf.VehicleStats {
+	return &protobuf.VehicleStats{
+		VehicleId:      int32(stats.VehicleID),
+		Count:          int32(stats.Count),
+		DistanceMeters: stats.Distance,
+		MovingTime:     durationpb.New(stats.MovingTime),
+		IdleTime:       durationpb.New(stats.IdleTime),
+		MaxSpeed:       int32(stats.MaxSpeed),
+		AvgSpeed:       stats.AvgSpeed,
+		Stops:          int32(stats.Stops),
+	}
+}
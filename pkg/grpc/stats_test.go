@@ -0,0 +1,70 @@
+package grpc
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"telematics-generator/pkg/cache"
+	"telematics-generator/pkg/models"
+	"telematics-generator/protobuf"
+)
+
+func TestGetVehicleStats(t *testing.T) {
+	c := cache.NewTelematicsDataCache(100)
+	s := NewServer(c)
+	start := time.Date(2023, 7, 12, 10, 0, 0, 0, time.UTC)
+
+	// Vehicle 1 drives 0.002 degrees east along the equator, about 222 m,
+	// and stops; vehicle 2 stands still. Records arrive out of order.
+	speeds := []int{10, 20, 0, 0}
+	for i := len(speeds) - 1; i >= 0; i-- {
+		ts := start.Add(time.Duration(i+1) * 10 * time.Second)
+		c.Add(models.TelematicsData{VehicleID: 1, Timestamp: ts, Speed: speeds[i], Longitude: math.Min(0.001*float64(i), 0.002)})
+		c.Add(models.TelematicsData{VehicleID: 2, Timestamp: ts, Latitude: 1})
+	}
+
+	req := &protobuf.VehicleStatsRequest{FromTimestamp: start.UnixNano(), ToTimestamp: start.Add(time.Minute).UnixNano()}
+	resp, err := s.GetVehicleStats(context.Background(), req)
+	if err != nil {
+		t.Fatalf("GetVehicleStats() error = %v", err)
+	}
+	if len(resp.Vehicles) != 2 {
+		t.Fatalf("GetVehicleStats() = %v, want 2 vehicles", resp.Vehicles)
+	}
+
+	v1, v2 := resp.Vehicles[0], resp.Vehicles[1]
+	if v1.VehicleId != 1 || v1.Count != 4 || v1.MaxSpeed != 20 || v1.AvgSpeed != 7.5 || v1.Stops != 1 ||
+		v1.MovingTime.AsDuration() != 20*time.Second || v1.IdleTime.AsDuration() != 10*time.Second ||
+		math.Abs(v1.DistanceMeters-222.4) > 0.5 {
+		t.Errorf("GetVehicleStats() vehicle 1 = %v", v1)
+	}
+	if v2.VehicleId != 2 || v2.DistanceMeters != 0 || v2.IdleTime.AsDuration() != 30*time.Second || v2.Stops != 0 {
+		t.Errorf("GetVehicleStats() vehicle 2 = %v", v2)
+	}
+	if resp.Fleet.Count != 8 || resp.Fleet.AvgSpeed != 3.75 || resp.Fleet.IdleTime.AsDuration() != 40*time.Second ||
+		resp.Fleet.DistanceMeters != v1.DistanceMeters {
+		t.Errorf("GetVehicleStats() fleet = %v", resp.Fleet)
+	}
+
+	req.VehicleIds = []int32{2, 2, 9}
+	resp, err = s.GetVehicleStats(context.Background(), req)
+	if err != nil {
+		t.Fatalf("GetVehicleStats() error = %v", err)
+	}
+	if len(resp.Vehicles) != 1 || resp.Vehicles[0].VehicleId != 2 || resp.Fleet.Count != 4 {
+		t.Errorf("GetVehicleStats() of vehicle 2 = %v", resp)
+	}
+
+	for _, req := range []*protobuf.VehicleStatsRequest{
+		{FromTimestamp: start.UnixNano(), ToTimestamp: start.Add(-time.Second).UnixNano()},
+		{ToTimestamp: start.UnixNano(), IdleSpeed: -1},
+	} {
+		if _, err := s.GetVehicleStats(context.Background(), req); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("GetVehicleStats(%v) error = %v, want InvalidArgument", req, err)
+		}
+	}
+}
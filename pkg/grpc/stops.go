@@ -0,0 +1,110 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"telematics-generator/pkg/track"
+	"telematics-generator/protobuf"
+)
+
+const (
+	defaultStopRadius      = 50
+	defaultStopMinDuration = 5 * time.Minute
+	defaultPlaceRadius     = 200
+	defaultMinVisits       = 2
+)
+
+// GetStops returns the stops and the frequently visited places of every
+// vehicle stopping in the requested range, ordered by vehicle ID.
+func (s *Server) GetStops(ctx context.Context, req *protobuf.StopsRequest) (*protobuf.StopsResponse, error) {
+	if req.ToTimestamp < req.FromTimestamp {
+		return nil, status.Error(codes.InvalidArgument, "to_timestamp should not be earlier than from_timestamp")
+	}
+	opts, minVisits, err := s.parseStopOptions(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	tracks, err := s.vehicleTracks(time.Unix(0, req.FromTimestamp), time.Unix(0, req.ToTimestamp), req.VehicleIds)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp := &protobuf.StopsResponse{}
+	for _, t := range tracks {
+		stops := track.DetectStops(t, opts)
+		if len(stops) == 0 {
+			continue
+		}
+		places := track.ClusterPlaces(stops, opts)
+
+		vehicle := &protobuf.VehicleStops{VehicleId: int32(t[0].VehicleID)}
+		for i, p := range places {
+			if p.Visits < minVisits {
+				break
+			}
+			vehicle.Places = append(vehicle.Places, &protobuf.Place{
+				PlaceId:  int32(i + 1),
+				Location: &protobuf.GeoPoint{Latitude: p.Latitude, Longitude: p.Longitude},
+				Visits:   int32(p.Visits),
+				Dwell:    durationpb.New(p.Dwell),
+			})
+		}
+		for _, stop := range stops {
+			placeID := 0
+			if stop.Place < len(vehicle.Places) {
+				placeID = stop.Place + 1
+			}
+			vehicle.Stops = append(vehicle.Stops, &protobuf.Stop{
+				Location:  &protobuf.GeoPoint{Latitude: stop.Latitude, Longitude: stop.Longitude},
+				Arrival:   stop.Arrival.UnixNano(),
+				Departure: stop.Departure.UnixNano(),
+				Duration:  durationpb.New(stop.Duration()),
+				Count:     int32(stop.Count),
+				PlaceId:   int32(placeID),
+			})
+		}
+		resp.Vehicles = append(resp.Vehicles, vehicle)
+	}
+
+	return resp, nil
+}
+
+func (s *Server) parseStopOptions(req *protobuf.StopsRequest) (track.StopOptions, int, error) {
+	opts := track.StopOptions{
+		MaxSpeed:    s.tripOptions.IdleSpeed,
+		Radius:      defaultStopRadius,
+		MinDuration: defaultStopMinDuration,
+		PlaceRadius: defaultPlaceRadius,
+	}
+	if req.StopSpeed != nil {
+		opts.MaxSpeed = int(req.GetStopSpeed())
+	}
+	if req.RadiusMeters != 0 {
+		opts.Radius = req.RadiusMeters
+	}
+	if req.MinDuration != nil {
+		if err := req.MinDuration.CheckValid(); err != nil {
+			return opts, 0, err
+		}
+		opts.MinDuration = req.MinDuration.AsDuration()
+	}
+	if req.PlaceRadiusMeters != 0 {
+		opts.PlaceRadius = req.PlaceRadiusMeters
+	}
+
+	minVisits := defaultMinVisits
+	if req.MinVisits < 0 {
+		return opts, 0, errors.New("min_visits should not be negative")
+	}
+	if req.MinVisits > 0 {
+		minVisits = int(req.MinVisits)
+	}
+
+	return opts, minVisits, opts.Validate()
+}
@@ -0,0 +1,81 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"telematics-generator/pkg/cache"
+	"telematics-generator/pkg/models"
+	"telematics-generator/protobuf"
+)
+
+func TestGetStops(t *testing.T) {
+	c := cache.NewTelematicsDataCache(100)
+	s := NewServer(c)
+	start := time.Date(2023, 7, 12, 10, 0, 0, 0, time.UTC)
+	add := func(vehicleID, minutes, speed int, lat float64) {
+		c.Add(models.TelematicsData{VehicleID: vehicleID, Timestamp: start.Add(time.Duration(minutes) * time.Minute), Speed: speed, Latitude: lat})
+	}
+
+	// Vehicle 1 stops at the depot twice and at a customer once, vehicle 2
+	// never stops.
+	for _, r := range []struct{ minutes, speed int }{{0, 0}, {10, 0}, {11, 50}, {20, 0}, {30, 0}, {31, 50}, {40, 0}, {45, 0}, {46, 50}} {
+		lat := 0.0
+		if r.minutes >= 20 && r.minutes <= 30 {
+			lat = 0.1
+		}
+		add(1, r.minutes, r.speed, lat)
+		add(2, r.minutes, 40, 0)
+	}
+
+	req := &protobuf.StopsRequest{FromTimestamp: start.Add(-time.Minute).UnixNano(), ToTimestamp: start.Add(time.Hour).UnixNano()}
+	resp, err := s.GetStops(context.Background(), req)
+	if err != nil {
+		t.Fatalf("GetStops() error = %v", err)
+	}
+	if len(resp.Vehicles) != 1 || resp.Vehicles[0].VehicleId != 1 {
+		t.Fatalf("GetStops() = %v, want stops of vehicle 1", resp.Vehicles)
+	}
+
+	v := resp.Vehicles[0]
+	if len(v.Stops) != 3 || len(v.Places) != 1 {
+		t.Fatalf("GetStops() = %v, want 3 stops and the depot", v)
+	}
+	if v.Places[0].PlaceId != 1 || v.Places[0].Visits != 2 || v.Places[0].Dwell.AsDuration() != 15*time.Minute {
+		t.Errorf("GetStops() place = %v, want the depot with 2 visits", v.Places[0])
+	}
+	for i, placeID := range []int32{1, 0, 1} {
+		if v.Stops[i].PlaceId != placeID {
+			t.Errorf("GetStops() stop %d = %v, want place %d", i, v.Stops[i], placeID)
+		}
+	}
+	if v.Stops[1].Arrival != start.Add(20*time.Minute).UnixNano() || v.Stops[1].Duration.AsDuration() != 10*time.Minute {
+		t.Errorf("GetStops() stop at the customer = %v", v.Stops[1])
+	}
+
+	req.MinDuration = durationpb.New(6 * time.Minute)
+	req.MinVisits = 1
+	resp, err = s.GetStops(context.Background(), req)
+	if err != nil {
+		t.Fatalf("GetStops() error = %v", err)
+	}
+	if len(resp.Vehicles[0].Stops) != 2 || len(resp.Vehicles[0].Places) != 2 {
+		t.Errorf("GetStops() of stops of 6 minutes = %v, want 2 stops at 2 places", resp.Vehicles[0])
+	}
+
+	from, to := req.FromTimestamp, req.ToTimestamp
+	for _, req := range []*protobuf.StopsRequest{
+		{FromTimestamp: to, ToTimestamp: from},
+		{FromTimestamp: from, ToTimestamp: to, RadiusMeters: -1},
+		{FromTimestamp: from, ToTimestamp: to, MinVisits: -1},
+		{FromTimestamp: from, ToTimestamp: to, MinDuration: durationpb.New(-time.Second)},
+	} {
+		if _, err := s.GetStops(context.Background(), req); status.Code(err) != codes.InvalidArgument {
+			t.Errorf("GetStops(%v) error = %v, want InvalidArgument", req, err)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package grpc
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"telematics-generator/pkg/cache"
+	"telematics-generator/pkg/models"
+	"telematics-generator/protobuf"
+	"time"
+)
+
+// subscriptionBuffer is how many records a subscriber may lag behind before
+// it is disconnected.
+const subscriptionBuffer = 1024
+
+// SubscribeTelematics streams the records matching the request as they are
+// added. A subscriber that falls behind by more than subscriptionBuffer
+// records gets ResourceExhausted and can resubscribe from the timestamp of
+// the last record it received.
+func (s *Server) SubscribeTelematics(req *protobuf.SubscribeRequest, srv protobuf.TelematicsDataService_SubscribeTelematicsServer) error {
+	if s.hub == nil {
+		return status.Error(codes.Unimplemented, "live subscriptions are not enabled")
+	}
+
+	filter, err := s.recordFilter(req.VehicleIds, req.FleetProfiles, req.BoundingBox, req.MinSpeed)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	now := s.now()
+	from := time.Unix(0, req.FromTimestamp)
+	if req.FromTimestamp != 0 && from.After(now) {
+		return status.Error(codes.InvalidArgument, "from_timestamp should not be in the future")
+	}
+
+	sub := s.hub.Subscribe(filter, subscriptionBuffer)
+	defer sub.Close()
+
+	// Records added while the history is sent are delivered by the
+	// subscription as well, replayed ones are skipped there.
+	replayed := make(map[int]time.Time)
+	if req.FromTimestamp != 0 {
+		history, err := s.cache.GetRangeBounds(from, now, cache.IncludeBoth)
+		if err != nil {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+		for i := len(history) - 1; i >= 0; i-- {
+			d := history[i]
+			if !filter(d) {
+				continue
+			}
+			if err := srv.Send(toProto(d)); err != nil {
+				return err
+			}
+			if d.Timestamp.After(replayed[d.VehicleID]) {
+				replayed[d.VehicleID] = d.Timestamp
+			}
+		}
+	}
+
+	send := func(d models.TelematicsData) error {
+		if last, ok := replayed[d.VehicleID]; ok && !d.Timestamp.After(last) {
+			return nil
+		}
+		return srv.Send(toProto(d))
+	}
+
+	for {
+		select {
+		case <-srv.Context().Done():
+			return status.FromContextError(srv.Context().Err()).Err()
+		case d := <-sub.C():
+			if err := send(d); err != nil {
+				return err
+			}
+		case <-sub.Done():
+			for len(sub.C()) > 0 {
+				if err := send(<-sub.C()); err != nil {
+					return err
+				}
+			}
+			return status.Errorf(codes.ResourceExhausted,
+				"%v, resubscribe with from_timestamp set to the timestamp of the last received record", sub.Err())
+		}
+	}
+}
@@ -0,0 +1,144 @@
+package grpc
+
+import (
+	"context"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"telematics-generator/pkg/cache"
+	"telematics-generator/pkg/fleet"
+	"telematics-generator/pkg/models"
+	"telematics-generator/pkg/pubsub"
+	"telematics-generator/protobuf"
+	"testing"
+	"time"
+)
+
+type mockSubscribeServer struct {
+	grpc.ServerStream
+	ctx       context.Context
+	responses chan *protobuf.TelematicsDataProto
+}
+
+func newMockSubscribeServer(ctx context.Context, buffer int) *mockSubscribeServer {
+	return &mockSubscribeServer{ctx: ctx, responses: make(chan *protobuf.TelematicsDataProto, buffer)}
+}
+
+func (m *mockSubscribeServer) Context() context.Context {
+	return m.ctx
+}
+
+func (m *mockSubscribeServer) Send(resp *protobuf.TelematicsDataProto) error {
+	m.responses <- resp
+	return nil
+}
+
+func TestSubscribeTelematics(t *testing.T) {
+	c := cache.NewTelematicsDataCache(100)
+	hub := pubsub.NewHub()
+	f := fleet.New([]fleet.Profile{{Name: "car", Share: 50}, {Name: "truck", Share: 50}}, 4)
+	s := NewServer(c, WithHub(hub), WithFleet(f))
+	now := time.Now()
+
+	add := func(d models.TelematicsData) {
+		c.Add(d)
+		hub.Publish(d)
+	}
+	add(models.TelematicsData{VehicleID: 3, Timestamp: now.Add(-2 * time.Second), Speed: 10, Latitude: 50, Longitude: 30})
+	add(models.TelematicsData{VehicleID: 1, Timestamp: now.Add(-time.Second), Speed: 20, Latitude: 50, Longitude: 30})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := newMockSubscribeServer(ctx, 10)
+	done := make(chan error)
+	go func() {
+		done <- s.SubscribeTelematics(&protobuf.SubscribeRequest{
+			FleetProfiles: []string{"truck"},
+			BoundingBox: &protobuf.BoundingBox{
+				Min: &protobuf.GeoPoint{Latitude: 49, Longitude: 29},
+				Max: &protobuf.GeoPoint{Latitude: 51, Longitude: 31},
+			},
+			MinSpeed:      5,
+			FromTimestamp: now.Add(-time.Minute).UnixNano(),
+		}, stream)
+	}()
+
+	if d := <-stream.responses; d.VehicleId != 3 || d.Speed != 10 {
+		t.Errorf("replayed record = %v, want the record of vehicle 3", d)
+	}
+
+	for hub.Len() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	add(models.TelematicsData{VehicleID: 4, Timestamp: now, Speed: 1, Latitude: 50, Longitude: 30})
+	add(models.TelematicsData{VehicleID: 4, Timestamp: now, Speed: 30, Latitude: 60, Longitude: 30})
+	add(models.TelematicsData{VehicleID: 2, Timestamp: now, Speed: 30, Latitude: 50, Longitude: 30})
+	add(models.TelematicsData{VehicleID: 4, Timestamp: now, Speed: 40, Latitude: 50, Longitude: 30})
+
+	if d := <-stream.responses; d.VehicleId != 4 || d.Speed != 40 {
+		t.Errorf("live record = %v, want the record of vehicle 4 with speed 40", d)
+	}
+
+	cancel()
+	if err := <-done; status.Code(err) != codes.Canceled {
+		t.Errorf("SubscribeTelematics() error = %v, want Canceled", err)
+	}
+	if len(stream.responses) != 0 || hub.Len() != 0 {
+		t.Errorf("unexpected records %v or subscriptions %v left", len(stream.responses), hub.Len())
+	}
+}
+
+func TestSubscribeTelematicsSlowSubscriber(t *testing.T) {
+	hub := pubsub.NewHub()
+	s := NewServer(cache.NewTelematicsDataCache(10), WithHub(hub))
+
+	stream := newMockSubscribeServer(context.Background(), 0)
+	done := make(chan error)
+	go func() {
+		done <- s.SubscribeTelematics(&protobuf.SubscribeRequest{}, stream)
+	}()
+
+	for hub.Len() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	for i := 0; i < 2*subscriptionBuffer; i++ {
+		hub.Publish(models.TelematicsData{VehicleID: 1, Timestamp: time.Unix(0, int64(i))})
+	}
+
+	received := 0
+	for {
+		select {
+		case <-stream.responses:
+			received++
+			continue
+		case err := <-done:
+			if status.Code(err) != codes.ResourceExhausted {
+				t.Errorf("SubscribeTelematics() error = %v, want ResourceExhausted", err)
+			}
+		}
+		break
+	}
+	if received < subscriptionBuffer {
+		t.Errorf("received %v records before disconnect, want at least the buffered %v", received, subscriptionBuffer)
+	}
+}
+
+func TestSubscribeTelematicsInvalidRequest(t *testing.T) {
+	c := cache.NewTelematicsDataCache(10)
+	c.Add(models.TelematicsData{VehicleID: 1, Timestamp: time.Now().Add(-2 * time.Hour)})
+	s := NewServer(c, WithHub(pubsub.NewHub()))
+
+	invalid := []*protobuf.SubscribeRequest{
+		{FleetProfiles: []string{"car"}},
+		{MinSpeed: -1},
+		{BoundingBox: &protobuf.BoundingBox{Min: &protobuf.GeoPoint{Latitude: 100}}},
+		{FromTimestamp: time.Now().Add(time.Hour).UnixNano()},
+		// After the last cached record.
+		{FromTimestamp: time.Now().Add(-time.Hour).UnixNano()},
+	}
+	for _, req := range invalid {
+		err := s.SubscribeTelematics(req, newMockSubscribeServer(context.Background(), 0))
+		if status.Code(err) != codes.InvalidArgument {
+			t.Errorf("SubscribeTelematics(%v) error = %v, want InvalidArgument", req, err)
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"telematics-generator/pkg/cache"
+	"telematics-generator/pkg/track"
+	"telematics-generator/protobuf"
+)
+
+// WithTripOptions sets the trip settings requests default to.
+func WithTripOptions(opts track.TripOptions) Option {
+	return func(s *Server) {
+		s.tripOptions = opts
+	}
+}
+
+// ListTrips returns the trips of every vehicle matching the request, ordered
+// by vehicle and start time. Trips are split from the records within the
+// requested range only, so trips crossing its ends are cut.
+func (s *Server) ListTrips(ctx context.Context, req *protobuf.ListTripsRequest) (*protobuf.ListTripsResponse, error) {
+	if req.ToTimestamp < req.FromTimestamp {
+		return nil, status.Error(codes.InvalidArgument, "to_timestamp should not be earlier than from_timestamp")
+	}
+	opts, err := s.parseTripOptions(req.Options)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	tracks, err := s.vehicleTracks(time.Unix(0, req.FromTimestamp), time.Unix(0, req.ToTimestamp), req.VehicleIds)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	resp := &protobuf.ListTripsResponse{}
+	for _, t := range tracks {
+		for _, trip := range track.SplitTrips(t, opts) {
+			resp.Trips = append(resp.Trips, tripToProto(trip.TripSummary))
+		}
+	}
+
+	return resp, nil
+}
+
+// GetTrip returns the trip with its points. The trip is split anew from the
+// cached records of the vehicle since its start, so an ongoing trip grows
+// until it ends.
+func (s *Server) GetTrip(ctx context.Context, req *protobuf.GetTripRequest) (*protobuf.Trip, error) {
+	vehicleID, start, err := track.ParseTripID(req.TripId)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	opts, err := s.parseTripOptions(req.Options)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	notFound := status.Errorf(codes.NotFound, "trip %s is not found", req.TripId)
+	latest, ok := s.cache.GetLatestForVehicle(vehicleID)
+	if !ok || latest.Timestamp.Before(start) {
+		return nil, notFound
+	}
+	records, err := s.cache.GetRangeForVehicle(vehicleID, start, latest.Timestamp, cache.IncludeBoth)
+	if errors.Is(err, cache.ErrUnknownVehicle) || errors.Is(err, cache.ErrOutOfRange) {
+		return nil, notFound
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	for i, j := 0, len(records)-1; i < j; i, j = i+1, j-1 {
+		records[i], records[j] = records[j], records[i]
+	}
+	trips := track.SplitTrips(records, opts)
+	if len(trips) == 0 || trips[0].ID() != req.TripId {
+		return nil, notFound
+	}
+
+	trip := tripToProto(trips[0].TripSummary)
+	for _, d := range trips[0].Points {
+		trip.Points = append(trip.Points, toProto(d))
+	}
+	return trip, nil
+}
+
+func (s *Server) parseTripOptions(o *protobuf.TripOptions) (track.TripOptions, error) {
+	opts := s.tripOptions
+	if o != nil && o.IdleSpeed != nil {
+		opts.IdleSpeed = int(o.GetIdleSpeed())
+	}
+	if o.GetMinStop() != nil {
+		if err := o.GetMinStop().CheckValid(); err != nil {
+			return opts, err
+		}
+		opts.MinStop = o.GetMinStop().AsDuration()
+	}
+	return opts, opts.Validate()
+}
+
+func tripToProto(t track.TripSummary) *protobuf.Trip {
+	return &protobuf.Trip{
+		TripId:         t.ID(),
+		VehicleId:      int32(t.VehicleID),
+		Start:          toProto(t.Start),
+		End:            toProto(t.End),
+		DistanceMeters: t.Distance,
+		Duration:       durationpb.New(t.Duration()),
+		MaxSpeed:       int32(t.MaxSpeed),
+		Ongoing:        t.Ongoing,
+	}
+}
@@ -0,0 +1,83 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"telematics-generator/pkg/cache"
+	"telematics-generator/pkg/models"
+	"telematics-generator/pkg/track"
+	"telematics-generator/protobuf"
+)
+
+func TestTrips(t *testing.T) {
+	c := cache.NewTelematicsDataCache(100)
+	s := NewServer(c, WithTripOptions(track.TripOptions{IdleSpeed: 5, MinStop: 3 * time.Minute}))
+	start := time.Date(2023, 7, 12, 10, 0, 0, 0, time.UTC)
+
+	// Vehicle 1 makes a trip with a short stop and starts another one,
+	// vehicle 2 stays parked.
+	speeds := []int{0, 50, 0, 60, 0, 0, 0, 0, 40}
+	for i, speed := range speeds {
+		ts := start.Add(time.Duration(i) * time.Minute)
+		c.Add(models.TelematicsData{VehicleID: 1, Timestamp: ts, Speed: speed, Longitude: 0.001 * float64(i)})
+		c.Add(models.TelematicsData{VehicleID: 2, Timestamp: ts})
+	}
+
+	req := &protobuf.ListTripsRequest{FromTimestamp: start.Add(-time.Minute).UnixNano(), ToTimestamp: start.Add(time.Hour).UnixNano()}
+	resp, err := s.ListTrips(context.Background(), req)
+	if err != nil {
+		t.Fatalf("ListTrips() error = %v", err)
+	}
+	if len(resp.Trips) != 2 {
+		t.Fatalf("ListTrips() = %v, want 2 trips", resp.Trips)
+	}
+	first, second := resp.Trips[0], resp.Trips[1]
+	if first.VehicleId != 1 || first.Ongoing || first.Start.Timestamp != start.UnixNano() ||
+		first.End.Timestamp != start.Add(4*time.Minute).UnixNano() || first.Duration.AsDuration() != 4*time.Minute ||
+		first.MaxSpeed != 60 || first.DistanceMeters < 444 || len(first.Points) != 0 {
+		t.Errorf("ListTrips() first trip = %v", first)
+	}
+	if !second.Ongoing || second.Start.Timestamp != start.Add(7*time.Minute).UnixNano() {
+		t.Errorf("ListTrips() second trip = %v", second)
+	}
+
+	// A longer min stop merges the trips.
+	req.Options = &protobuf.TripOptions{MinStop: durationpb.New(time.Hour)}
+	resp, err = s.ListTrips(context.Background(), req)
+	if err != nil {
+		t.Fatalf("ListTrips() error = %v", err)
+	}
+	if len(resp.Trips) != 1 || !resp.Trips[0].Ongoing {
+		t.Errorf("ListTrips() with 1h min stop = %v, want one ongoing trip", resp.Trips)
+	}
+
+	trip, err := s.GetTrip(context.Background(), &protobuf.GetTripRequest{TripId: first.TripId})
+	if err != nil {
+		t.Fatalf("GetTrip() error = %v", err)
+	}
+	if trip.TripId != first.TripId || trip.DistanceMeters != first.DistanceMeters || len(trip.Points) != 5 ||
+		trip.Points[4].Timestamp != first.End.Timestamp {
+		t.Errorf("GetTrip() = %v, want %v with 5 points", trip, first)
+	}
+
+	idle := int32(100)
+	for _, tc := range []struct {
+		req  *protobuf.GetTripRequest
+		code codes.Code
+	}{
+		{&protobuf.GetTripRequest{TripId: "1-x"}, codes.InvalidArgument},
+		{&protobuf.GetTripRequest{TripId: first.TripId, Options: &protobuf.TripOptions{MinStop: durationpb.New(-time.Second)}}, codes.InvalidArgument},
+		{&protobuf.GetTripRequest{TripId: first.TripId, Options: &protobuf.TripOptions{IdleSpeed: &idle}}, codes.NotFound},
+		{&protobuf.GetTripRequest{TripId: "1-1"}, codes.NotFound},
+		{&protobuf.GetTripRequest{TripId: "3-1"}, codes.NotFound},
+	} {
+		if _, err := s.GetTrip(context.Background(), tc.req); status.Code(err) != tc.code {
+			t.Errorf("GetTrip(%v) error = %v, want %v", tc.req, err, tc.code)
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package heatmap
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"telematics-generator/pkg/models"
+)
+
+// Cell is a grid cell identified by ID and located at its center.
+type Cell struct {
+	ID        string
+	Latitude  float64
+	Longitude float64
+}
+
+// Grid assigns points given in degrees to cells.
+type Grid interface {
+	Cell(lat, lng float64) Cell
+}
+
+const geohashAlphabet = "0123456789bcdefghjkmnpqrstuvwxyz"
+
+type geohashGrid struct {
+	precision int
+}
+
+// Geohash returns the grid of geohash cells of the precision in characters.
+func Geohash(precision int) (Grid, error) {
+	if precision < 1 || precision > 12 {
+		return nil, errors.New("geohash precision should be from 1 to 12")
+	}
+	return geohashGrid{precision: precision}, nil
+}
+
+func (g geohashGrid) Cell(lat, lng float64) Cell {
+	minLat, maxLat := -90.0, 90.0
+	minLng, maxLng := -180.0, 180.0
+
+	hash := make([]byte, 0, g.precision)
+	bits, ch, even := 0, 0, true
+	for len(hash) < g.precision {
+		if even {
+			if mid := (minLng + maxLng) / 2; lng >= mid {
+				ch, minLng = ch<<1|1, mid
+			} else {
+				ch, maxLng = ch<<1, mid
+			}
+		} else {
+			if mid := (minLat + maxLat) / 2; lat >= mid {
+				ch, minLat = ch<<1|1, mid
+			} else {
+				ch, maxLat = ch<<1, mid
+			}
+		}
+		even = !even
+		if bits++; bits == 5 {
+			hash = append(hash, geohashAlphabet[ch])
+			bits, ch = 0, 0
+		}
+	}
+
+	return Cell{ID: string(hash), Latitude: (minLat + maxLat) / 2, Longitude: (minLng + maxLng) / 2}
+}
+
+// Squares and hexagons are laid out on the sinusoidal projection, which keeps
+// areas, so every cell covers the same area of the Earth's surface.
+
+type squareGrid struct {
+	size float64
+}
+
+// Squares returns the grid of square cells with the side in metres.
+func Squares(size float64) (Grid, error) {
+	if !(size >= 1) {
+		return nil, errors.New("cell size should be at least 1 metre")
+	}
+	return squareGrid{size: size}, nil
+}
+
+func (g squareGrid) Cell(lat, lng float64) Cell {
+	x, y := project(lat, lng)
+	col, row := math.Floor(x/g.size), math.Floor(y/g.size)
+	lat, lng = unproject((col+0.5)*g.size, (row+0.5)*g.size)
+	return Cell{ID: fmt.Sprintf("s%.0f:%.0f", row, col), Latitude: lat, Longitude: lng}
+}
+
+type hexGrid struct {
+	radius float64
+}
+
+// Hexagons returns the grid of pointy-top hexagonal cells with the width
+// between opposite sides in metres.
+func Hexagons(size float64) (Grid, error) {
+	if !(size >= 1) {
+		return nil, errors.New("cell size should be at least 1 metre")
+	}
+	return hexGrid{radius: size / math.Sqrt(3)}, nil
+}
+
+func (g hexGrid) Cell(lat, lng float64) Cell {
+	x, y := project(lat, lng)
+
+	// Axial coordinates of the point rounded to the nearest hexagon center
+	// in cube coordinates.
+	q := (math.Sqrt(3)/3*x - y/3) / g.radius
+	r := 2.0 / 3 * y / g.radius
+	s := -q - r
+	rq, rr, rs := math.Round(q), math.Round(r), math.Round(s)
+	dq, dr, ds := math.Abs(rq-q), math.Abs(rr-r), math.Abs(rs-s)
+	if dq > dr && dq > ds {
+		rq = -rr - rs
+	} else if dr > ds {
+		rr = -rq - rs
+	}
+
+	lat, lng = unproject(g.radius*math.Sqrt(3)*(rq+rr/2), g.radius*1.5*rr)
+	return Cell{ID: fmt.Sprintf("h%.0f:%.0f", rq, rr), Latitude: lat, Longitude: lng}
+}
+
+// project returns the sinusoidal projection of the point in metres.
+func project(lat, lng float64) (float64, float64) {
+	φ, λ := lat*math.Pi/180, lng*math.Pi/180
+	return models.EarthRadius * λ * math.Cos(φ), models.EarthRadius * φ
+}
+
+func unproject(x, y float64) (float64, float64) {
+	φ := math.Max(-math.Pi/2, math.Min(math.Pi/2, y/models.EarthRadius))
+	lng := 0.0
+	if c := math.Cos(φ); c > 1e-12 {
+		lng = math.Remainder(x/(models.EarthRadius*c)*180/math.Pi, 360)
+	}
+	return φ * 180 / math.Pi, lng
+}
@@ -0,0 +1,76 @@
+package heatmap
+
+import (
+	"math"
+	"testing"
+
+	geo "github.com/kellydunn/golang-geo"
+)
+
+func TestGeohash(t *testing.T) {
+	g, err := Geohash(11)
+	if err != nil {
+		t.Fatalf("Geohash() error = %v", err)
+	}
+	if cell := g.Cell(57.64911, 10.40744); cell.ID != "u4pruydqqvj" ||
+		math.Abs(cell.Latitude-57.64911) > 1e-5 || math.Abs(cell.Longitude-10.40744) > 1e-5 {
+		t.Errorf("Cell() = %+v, want u4pruydqqvj around the point", cell)
+	}
+
+	g, _ = Geohash(1)
+	if cell := g.Cell(-10, -170); cell.ID != "2" || cell.Latitude != -22.5 || cell.Longitude != -157.5 {
+		t.Errorf("Cell() = %+v, want cell 2", cell)
+	}
+
+	for _, precision := range []int{0, 13} {
+		if _, err := Geohash(precision); err == nil {
+			t.Errorf("Geohash(%d) error = nil, want an error", precision)
+		}
+	}
+}
+
+func TestSquaresAndHexagons(t *testing.T) {
+	squares, err := Squares(1000)
+	if err != nil {
+		t.Fatalf("Squares() error = %v", err)
+	}
+	hexagons, err := Hexagons(1000)
+	if err != nil {
+		t.Fatalf("Hexagons() error = %v", err)
+	}
+
+	for _, tc := range []struct {
+		grid Grid
+		// maxDistance is the distance from the center to the farthest point
+		// of a cell.
+		maxDistance float64
+	}{
+		{squares, 1000 * math.Sqrt2 / 2},
+		{hexagons, 1000 / math.Sqrt(3)},
+	} {
+		for _, p := range [][2]float64{{0, 0}, {50.45, 30.52}, {-33.9, 151.2}, {64.1, -21.9}, {0.004, 179.999}} {
+			cell := tc.grid.Cell(p[0], p[1])
+			d := geo.NewPoint(p[0], p[1]).GreatCircleDistance(geo.NewPoint(cell.Latitude, cell.Longitude)) * 1000
+			if d > tc.maxDistance*1.01 {
+				t.Errorf("%T.Cell(%v) = %+v, %.0f m from the point", tc.grid, p, cell, d)
+			}
+			if again := tc.grid.Cell(cell.Latitude, cell.Longitude); again.ID != cell.ID {
+				t.Errorf("%T.Cell() of the center of %v = %v", tc.grid, cell.ID, again.ID)
+			}
+		}
+
+		// Points 3 km apart are never in the same cell.
+		if a, b := tc.grid.Cell(50.45, 30.52), tc.grid.Cell(50.477, 30.52); a.ID == b.ID {
+			t.Errorf("%T.Cell() of points 3 km apart = %v", tc.grid, a.ID)
+		}
+	}
+
+	for _, size := range []float64{0, -1, math.NaN()} {
+		if _, err := Squares(size); err == nil {
+			t.Errorf("Squares(%v) error = nil, want an error", size)
+		}
+		if _, err := Hexagons(size); err == nil {
+			t.Errorf("Hexagons(%v) error = nil, want an error", size)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package heatmap
+
+import (
+	"sort"
+
+	"telematics-generator/pkg/models"
+)
+
+// Bin aggregates the records falling into a cell.
+type Bin struct {
+	Cell
+	Count    int
+	AvgSpeed float64
+	MaxSpeed int
+	speedSum int
+}
+
+// Aggregate bins the records into the cells of the grid and returns the
+// non-empty cells, the densest first and then by ID.
+func Aggregate(records []models.TelematicsData, g Grid) []Bin {
+	bins := make(map[string]*Bin)
+	for _, d := range records {
+		cell := g.Cell(d.Latitude, d.Longitude)
+		b, ok := bins[cell.ID]
+		if !ok {
+			b = &Bin{Cell: cell}
+			bins[cell.ID] = b
+		}
+		b.Count++
+		b.speedSum += d.Speed
+		if d.Speed > b.MaxSpeed {
+			b.MaxSpeed = d.Speed
+		}
+	}
+
+	result := make([]Bin, 0, len(bins))
+	for _, b := range bins {
+		b.AvgSpeed = float64(b.speedSum) / float64(b.Count)
+		result = append(result, *b)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Count != result[j].Count {
+			return result[i].Count > result[j].Count
+		}
+		return result[i].ID < result[j].ID
+	})
+
+	return result
+}
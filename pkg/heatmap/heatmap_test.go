@@ -0,0 +1,31 @@
+package heatmap
+
+import (
+	"telematics-generator/pkg/models"
+	"testing"
+)
+
+func TestAggregate(t *testing.T) {
+	g, _ := Geohash(5)
+	records := []models.TelematicsData{
+		{VehicleID: 1, Speed: 10, Latitude: 50.45, Longitude: 30.52},
+		{VehicleID: 2, Speed: 30, Latitude: 50.4501, Longitude: 30.5201},
+		{VehicleID: 3, Speed: 80, Latitude: 50.45, Longitude: 30.52},
+		{VehicleID: 1, Speed: 50, Latitude: 49.84, Longitude: 24.03},
+	}
+
+	bins := Aggregate(records, g)
+	if len(bins) != 2 {
+		t.Fatalf("Aggregate() = %+v, want 2 cells", bins)
+	}
+	if bins[0].Count != 3 || bins[0].AvgSpeed != 40 || bins[0].MaxSpeed != 80 || bins[0].ID != g.Cell(50.45, 30.52).ID {
+		t.Errorf("Aggregate()[0] = %+v, want 3 records with avg speed 40", bins[0])
+	}
+	if bins[1].Count != 1 || bins[1].AvgSpeed != 50 {
+		t.Errorf("Aggregate()[1] = %+v, want 1 record with avg speed 50", bins[1])
+	}
+
+	if bins := Aggregate(nil, g); len(bins) != 0 {
+		t.Errorf("Aggregate() of no records = %+v, want none", bins)
+	}
+}
@@ -0,0 +1,92 @@
+package kafka
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+	"google.golang.org/protobuf/proto"
+	"telematics-generator/protobuf"
+)
+
+// ReadSince calls fn for every message produced to the topic since the given
+// time, partition by partition. It stops at the end of every partition as of
+// the call instead of waiting for new messages.
+func ReadSince(ctx context.Context, broker, topic string, since time.Time, fn func(*protobuf.TelematicsDataProto)) error {
+	conn, err := kafka.DialContext(ctx, "tcp", broker)
+	if err != nil {
+		return err
+	}
+	partitions, err := conn.ReadPartitions(topic)
+	conn.Close()
+	if err != nil {
+		return err
+	}
+
+	for _, p := range partitions {
+		if err := readPartitionSince(ctx, broker, topic, p.ID, since, fn); err != nil {
+			return fmt.Errorf("failed to read partition %d: %w", p.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func readPartitionSince(ctx context.Context, broker, topic string, partition int, since time.Time, fn func(*protobuf.TelematicsDataProto)) error {
+	conn, err := kafka.DialLeader(ctx, "tcp", broker, topic, partition)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+
+	first, err := conn.ReadOffset(since)
+	if err != nil {
+		return err
+	}
+	last, err := conn.ReadLastOffset()
+	if err != nil {
+		return err
+	}
+	if first < 0 || first >= last {
+		return nil
+	}
+	if _, err := conn.Seek(first, kafka.SeekAbsolute); err != nil {
+		return err
+	}
+
+	for offset := first; offset < last; {
+		batchStart := offset
+		batch := conn.ReadBatch(1, 10e6)
+		for offset < last {
+			message, err := batch.ReadMessage()
+			if err != nil {
+				break
+			}
+			offset = message.Offset + 1
+
+			var telematicsData protobuf.TelematicsDataProto
+			if err := proto.Unmarshal(message.Value, &telematicsData); err != nil {
+				continue
+			}
+			fn(&telematicsData)
+		}
+		if err := batch.Close(); err != nil && !errors.Is(err, io.EOF) {
+			return err
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if offset == batchStart {
+			return fmt.Errorf("no messages read at offset %d of %d", offset, last)
+		}
+	}
+
+	return nil
+}
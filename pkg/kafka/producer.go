@@ -4,24 +4,46 @@ import (
 	"context"
 	"github.com/segmentio/kafka-go"
 	"log"
+	"strconv"
+	"sync"
 	"time"
 
 	"google.golang.org/protobuf/proto"
 	"telematics-generator/protobuf"
 )
 
+// errTTL is how long Err reports a failed write, so that a producer that
+// stays idle after a failure recovers.
+const errTTL = time.Minute
+
 type Producer struct {
 	writer *kafka.Writer
+	now    func() time.Time
+	mx     sync.Mutex
+	err    error
+	errAt  time.Time
 }
 
 func NewKafkaProducer(brokers []string, topic string) *Producer {
+	return newProducer(brokers, topic, &kafka.LeastBytes{})
+}
+
+// NewKeyedKafkaProducer returns a producer that writes messages with the same
+// key to the same partition, so that they are read in the order they were
+// written.
+func NewKeyedKafkaProducer(brokers []string, topic string) *Producer {
+	return newProducer(brokers, topic, &kafka.Hash{})
+}
+
+func newProducer(brokers []string, topic string, balancer kafka.Balancer) *Producer {
 	return &Producer{
 		writer: &kafka.Writer{
 			Addr:         kafka.TCP(brokers...),
 			Topic:        topic,
-			Balancer:     &kafka.LeastBytes{},
+			Balancer:     balancer,
 			BatchTimeout: 10 * time.Millisecond,
 		},
+		now: time.Now,
 	}
 }
 
@@ -31,7 +53,7 @@ func (kp *Producer) ProduceMessage(telematicsData *protobuf.TelematicsDataProto)
 		return err
 	}
 
-	err = kp.writer.WriteMessages(context.Background(), kafka.Message{
+	err = kp.write(kafka.Message{
 		Value: message,
 	})
 	if err != nil {
@@ -42,6 +64,72 @@ func (kp *Producer) ProduceMessage(telematicsData *protobuf.TelematicsDataProto)
 	return nil
 }
 
+// ProduceTripEvent publishes the trip event keyed by trip ID, so that with
+// a keyed producer the end of a trip follows its start.
+func (kp *Producer) ProduceTripEvent(event *protobuf.TripEvent) error {
+	message, err := proto.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	err = kp.write(kafka.Message{
+		Key:   []byte(event.Trip.GetTripId()),
+		Value: message,
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Printf("produced trip event: %s", event.String())
+	return nil
+}
+
+// ProduceCommandAck publishes the acknowledgement keyed by vehicle ID, so that
+// with a keyed producer the acknowledgements of a vehicle keep their order.
+func (kp *Producer) ProduceCommandAck(ack *protobuf.CommandAck) error {
+	message, err := proto.Marshal(ack)
+	if err != nil {
+		return err
+	}
+
+	err = kp.write(kafka.Message{
+		Key:   []byte(strconv.Itoa(int(ack.VehicleId))),
+		Value: message,
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Printf("produced command ack: %s", ack.String())
+	return nil
+}
+
+// Err returns the error of the last write if it failed less than errTTL ago,
+// nil if it succeeded, is older or nothing has been written yet.
+func (kp *Producer) Err() error {
+	kp.mx.Lock()
+	defer kp.mx.Unlock()
+
+	if kp.err != nil && kp.now().Sub(kp.errAt) >= errTTL {
+		kp.err = nil
+	}
+	return kp.err
+}
+
+func (kp *Producer) write(message kafka.Message) error {
+	err := kp.writer.WriteMessages(context.Background(), message)
+	kp.setErr(err)
+	return err
+}
+
+func (kp *Producer) setErr(err error) {
+	kp.mx.Lock()
+	defer kp.mx.Unlock()
+
+	kp.err = err
+	kp.errAt = kp.now()
+}
+
 func (kp *Producer) Close() error {
 	return kp.writer.Close()
 }
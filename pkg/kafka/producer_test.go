@@ -0,0 +1,45 @@
+package kafka
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/segmentio/kafka-go"
+)
+
+func TestProducerErrExpires(t *testing.T) {
+	now := time.Date(2023, 7, 12, 10, 0, 0, 0, time.UTC)
+	kp := &Producer{now: func() time.Time { return now }}
+
+	if err := kp.Err(); err != nil {
+		t.Errorf("Err() before any write = %v, want nil", err)
+	}
+
+	writeErr := errors.New("kafka is down")
+	kp.setErr(writeErr)
+	now = now.Add(errTTL - time.Second)
+	if err := kp.Err(); err != writeErr {
+		t.Errorf("Err() after a failed write = %v, want %v", err, writeErr)
+	}
+
+	kp.setErr(nil)
+	if err := kp.Err(); err != nil {
+		t.Errorf("Err() after a successful write = %v, want nil", err)
+	}
+
+	kp.setErr(writeErr)
+	now = now.Add(errTTL)
+	if err := kp.Err(); err != nil {
+		t.Errorf("Err() of a write failed %v ago = %v, want nil", errTTL, err)
+	}
+}
+
+func TestProducerBalancer(t *testing.T) {
+	if _, ok := NewKafkaProducer([]string{"localhost:9092"}, "topic").writer.Balancer.(*kafka.LeastBytes); !ok {
+		t.Errorf("NewKafkaProducer() should balance by least bytes")
+	}
+	if _, ok := NewKeyedKafkaProducer([]string{"localhost:9092"}, "topic").writer.Balancer.(*kafka.Hash); !ok {
+		t.Errorf("NewKeyedKafkaProducer() should balance by key hash")
+	}
+}
@@ -0,0 +1,7 @@
+package models
+
+import geo "github.com/kellydunn/golang-geo"
+
+// EarthRadius is the mean radius of the Earth in metres, the one golang-geo
+// measures great-circle distances with.
+const EarthRadius = geo.EARTH_RADIUS * 1000
@@ -0,0 +1,114 @@
+package pubsub
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+
+	"telematics-generator/pkg/models"
+)
+
+// ErrSlowSubscriber is the reason a subscription is closed when it does not
+// keep up with the published records.
+var ErrSlowSubscriber = errors.New("subscriber is too slow")
+
+// Hub delivers published records to subscribers. Publish never blocks: every
+// subscription has a buffer, and a subscription whose buffer is full is
+// closed with ErrSlowSubscriber instead of holding up the publisher.
+type Hub struct {
+	mx            sync.RWMutex
+	subscriptions map[*Subscription]struct{}
+}
+
+type Subscription struct {
+	hub    *Hub
+	filter func(models.TelematicsData) bool
+	c      chan models.TelematicsData
+	done   chan struct{}
+	once   sync.Once
+	slow   atomic.Bool
+	err    error
+}
+
+func NewHub() *Hub {
+	return &Hub{subscriptions: make(map[*Subscription]struct{})}
+}
+
+// Subscribe returns a subscription to the records for which filter returns
+// true. A nil filter matches every record.
+func (h *Hub) Subscribe(filter func(models.TelematicsData) bool, buffer int) *Subscription {
+	s := &Subscription{
+		hub:    h,
+		filter: filter,
+		c:      make(chan models.TelematicsData, buffer),
+		done:   make(chan struct{}),
+	}
+
+	h.mx.Lock()
+	defer h.mx.Unlock()
+	h.subscriptions[s] = struct{}{}
+
+	return s
+}
+
+func (h *Hub) Publish(telematicsData models.TelematicsData) {
+	h.mx.RLock()
+	defer h.mx.RUnlock()
+
+	for s := range h.subscriptions {
+		if s.slow.Load() || s.filter != nil && !s.filter(telematicsData) {
+			continue
+		}
+		select {
+		case s.c <- telematicsData:
+		default:
+			if s.slow.CompareAndSwap(false, true) {
+				go s.close(ErrSlowSubscriber)
+			}
+		}
+	}
+}
+
+// Len returns the number of open subscriptions.
+func (h *Hub) Len() int {
+	h.mx.RLock()
+	defer h.mx.RUnlock()
+
+	return len(h.subscriptions)
+}
+
+// C returns the channel the matching records are delivered to.
+func (s *Subscription) C() <-chan models.TelematicsData {
+	return s.c
+}
+
+// Done is closed when the subscription is closed, Err tells why.
+func (s *Subscription) Done() <-chan struct{} {
+	return s.done
+}
+
+// Err returns ErrSlowSubscriber if the hub closed the subscription, or nil.
+func (s *Subscription) Err() error {
+	select {
+	case <-s.done:
+		return s.err
+	default:
+		return nil
+	}
+}
+
+// Close stops the delivery of records to the subscription.
+func (s *Subscription) Close() {
+	s.close(nil)
+}
+
+func (s *Subscription) close(err error) {
+	s.once.Do(func() {
+		s.hub.mx.Lock()
+		delete(s.hub.subscriptions, s)
+		s.hub.mx.Unlock()
+
+		s.err = err
+		close(s.done)
+	})
+}
@@ -0,0 +1,52 @@
+package pubsub
+
+import (
+	"errors"
+	"telematics-generator/pkg/models"
+	"testing"
+	"time"
+)
+
+func TestHub(t *testing.T) {
+	h := NewHub()
+	all := h.Subscribe(nil, 10)
+	fast := h.Subscribe(func(d models.TelematicsData) bool { return d.Speed >= 50 }, 10)
+
+	for speed := 0; speed < 100; speed += 20 {
+		h.Publish(models.TelematicsData{VehicleID: 1, Speed: speed})
+	}
+
+	if len(all.C()) != 5 || len(fast.C()) != 2 {
+		t.Errorf("delivered %v and %v records, want 5 and 2", len(all.C()), len(fast.C()))
+	}
+	if d := <-fast.C(); d.Speed != 60 {
+		t.Errorf("first fast record = %v, want speed 60", d)
+	}
+
+	fast.Close()
+	if h.Len() != 1 || fast.Err() != nil {
+		t.Errorf("after Close() Len() = %v, Err() = %v, want 1 and nil", h.Len(), fast.Err())
+	}
+	all.Close()
+}
+
+func TestSlowSubscriberIsClosed(t *testing.T) {
+	h := NewHub()
+	slow := h.Subscribe(nil, 2)
+
+	for i := 0; i < 5; i++ {
+		h.Publish(models.TelematicsData{VehicleID: i})
+	}
+
+	select {
+	case <-slow.Done():
+	case <-time.After(time.Second):
+		t.Fatal("a slow subscription should be closed")
+	}
+	if !errors.Is(slow.Err(), ErrSlowSubscriber) || h.Len() != 0 {
+		t.Errorf("Err() = %v, Len() = %v, want ErrSlowSubscriber and no subscriptions", slow.Err(), h.Len())
+	}
+	if len(slow.C()) != 2 {
+		t.Errorf("buffered %v records, want the first 2 to be kept", len(slow.C()))
+	}
+}
@@ -0,0 +1,58 @@
+package track
+
+import (
+	"math"
+	"time"
+
+	"telematics-generator/pkg/models"
+)
+
+// Interpolate returns the record of the vehicle at t between its records a
+// and b, with a not after b. The position moves along the great circle from
+// a to b and the speed changes linearly, both in proportion to the time
+// elapsed since a. Outside [a, b] the nearest record is returned.
+func Interpolate(a, b models.TelematicsData, t time.Time) models.TelematicsData {
+	if !t.After(a.Timestamp) || !a.Timestamp.Before(b.Timestamp) {
+		return at(a, t)
+	}
+	if !t.Before(b.Timestamp) {
+		return at(b, t)
+	}
+
+	f := float64(t.Sub(a.Timestamp)) / float64(b.Timestamp.Sub(a.Timestamp))
+	lat, lng := intermediatePoint(a.Latitude, a.Longitude, b.Latitude, b.Longitude, f)
+
+	return models.TelematicsData{
+		VehicleID: a.VehicleID,
+		Timestamp: t,
+		Speed:     int(math.Round(float64(a.Speed) + f*float64(b.Speed-a.Speed))),
+		Latitude:  lat,
+		Longitude: lng,
+	}
+}
+
+func at(d models.TelematicsData, t time.Time) models.TelematicsData {
+	d.Timestamp = t
+	return d
+}
+
+// intermediatePoint returns the point at fraction f of the great circle arc
+// between two points given in degrees.
+func intermediatePoint(lat1, lng1, lat2, lng2, f float64) (float64, float64) {
+	φ1, λ1 := lat1*math.Pi/180, lng1*math.Pi/180
+	φ2, λ2 := lat2*math.Pi/180, lng2*math.Pi/180
+
+	x1, y1, z1 := math.Cos(φ1)*math.Cos(λ1), math.Cos(φ1)*math.Sin(λ1), math.Sin(φ1)
+	x2, y2, z2 := math.Cos(φ2)*math.Cos(λ2), math.Cos(φ2)*math.Sin(λ2), math.Sin(φ2)
+
+	δ := math.Acos(math.Max(-1, math.Min(1, x1*x2+y1*y2+z1*z2)))
+	if δ < 1e-12 {
+		return lat1 + f*(lat2-lat1), lng1 + f*(lng2-lng1)
+	}
+
+	a := math.Sin((1-f)*δ) / math.Sin(δ)
+	b := math.Sin(f*δ) / math.Sin(δ)
+	x, y, z := a*x1+b*x2, a*y1+b*y2, a*z1+b*z2
+
+	return math.Atan2(z, math.Hypot(x, y)) * 180 / math.Pi, math.Atan2(y, x) * 180 / math.Pi
+}
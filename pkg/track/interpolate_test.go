@@ -0,0 +1,40 @@
+package track
+
+import (
+	"math"
+	"telematics-generator/pkg/models"
+	"testing"
+	"time"
+)
+
+func TestInterpolate(t *testing.T) {
+	start := time.Date(2023, 7, 12, 10, 0, 0, 0, time.UTC)
+	a := models.TelematicsData{VehicleID: 1, Timestamp: start, Speed: 10, Latitude: 0, Longitude: 0}
+	b := models.TelematicsData{VehicleID: 1, Timestamp: start.Add(time.Minute), Speed: 30, Latitude: 0, Longitude: 90}
+
+	mid := Interpolate(a, b, start.Add(30*time.Second))
+	if mid.Speed != 20 || math.Abs(mid.Latitude) > 1e-9 || math.Abs(mid.Longitude-45) > 1e-9 || !mid.Timestamp.Equal(start.Add(30*time.Second)) {
+		t.Errorf("Interpolate() on the equator = %v, want speed 20 at 0, 45", mid)
+	}
+
+	// The great circle between two points at the same latitude bends towards
+	// the pole, unlike the straight line in latitude and longitude.
+	a.Latitude, b.Latitude = 60, 60
+	a.Longitude, b.Longitude = -30, 30
+	mid = Interpolate(a, b, start.Add(30*time.Second))
+	if math.Abs(mid.Longitude) > 1e-9 || mid.Latitude <= 60 {
+		t.Errorf("Interpolate() along a parallel = %v, want a point north of 60 on the meridian", mid)
+	}
+
+	if before := Interpolate(a, b, start.Add(-time.Second)); before.Latitude != a.Latitude || before.Speed != a.Speed {
+		t.Errorf("Interpolate() before the first record = %v, want its position", before)
+	}
+	if after := Interpolate(a, b, start.Add(time.Hour)); after.Longitude != b.Longitude || after.Speed != b.Speed {
+		t.Errorf("Interpolate() after the last record = %v, want its position", after)
+	}
+
+	same := Interpolate(a, a, start)
+	if same.Latitude != a.Latitude || same.Longitude != a.Longitude {
+		t.Errorf("Interpolate() between equal records = %v, want %v", same, a)
+	}
+}
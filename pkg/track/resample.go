@@ -0,0 +1,38 @@
+package track
+
+import (
+	"time"
+
+	"telematics-generator/pkg/models"
+)
+
+// Resample returns the positions of the vehicle at every multiple of interval
+// since the Unix epoch between its first and last record, interpolated
+// between the neighbouring records. The track should be the records of one
+// vehicle sorted by timestamp ascending.
+func Resample(track []models.TelematicsData, interval time.Duration) []models.TelematicsData {
+	if len(track) == 0 || interval <= 0 {
+		return nil
+	}
+
+	first, last := track[0].Timestamp, track[len(track)-1].Timestamp
+	t := first.Truncate(interval)
+	if t.Before(first) {
+		t = t.Add(interval)
+	}
+
+	var result []models.TelematicsData
+	i := 0
+	for ; !t.After(last); t = t.Add(interval) {
+		for i+1 < len(track) && !track[i+1].Timestamp.After(t) {
+			i++
+		}
+		if i+1 < len(track) {
+			result = append(result, Interpolate(track[i], track[i+1], t))
+		} else {
+			result = append(result, at(track[i], t))
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,36 @@
+package track
+
+import (
+	"telematics-generator/pkg/models"
+	"testing"
+	"time"
+)
+
+func TestResample(t *testing.T) {
+	start := time.Date(2023, 7, 12, 10, 0, 0, 0, time.UTC)
+	track := []models.TelematicsData{
+		{VehicleID: 1, Timestamp: start.Add(3 * time.Second), Speed: 10},
+		{VehicleID: 1, Timestamp: start.Add(13 * time.Second), Speed: 20},
+		{VehicleID: 1, Timestamp: start.Add(14 * time.Second), Speed: 20},
+		{VehicleID: 1, Timestamp: start.Add(30 * time.Second), Speed: 40},
+	}
+
+	got := Resample(track, 5*time.Second)
+	wantSpeeds := []int{12, 17, 21, 28, 34, 40}
+	if len(got) != len(wantSpeeds) {
+		t.Fatalf("Resample() = %v, want %d records", got, len(wantSpeeds))
+	}
+	for i, d := range got {
+		want := start.Add(time.Duration(i+1) * 5 * time.Second)
+		if !d.Timestamp.Equal(want) || d.Speed != wantSpeeds[i] || d.VehicleID != 1 {
+			t.Errorf("Resample()[%d] = %v, want speed %d at %v", i, d, wantSpeeds[i], want)
+		}
+	}
+
+	if got := Resample(track[:1], 5*time.Second); len(got) != 0 {
+		t.Errorf("Resample() of a record between grid points = %v, want none", got)
+	}
+	if got := Resample(nil, time.Second); got != nil {
+		t.Errorf("Resample() of an empty track = %v, want nil", got)
+	}
+}
@@ -0,0 +1,67 @@
+package track
+
+import (
+	"math"
+
+	"telematics-generator/pkg/models"
+)
+
+// Simplify reduces the track with the Douglas-Peucker algorithm, keeping the
+// first and last records and every record needed for the simplified track to
+// stay within tolerance metres of the original one. The track should be the
+// records of one vehicle sorted by timestamp ascending.
+func Simplify(track []models.TelematicsData, tolerance float64) []models.TelematicsData {
+	if len(track) < 3 || tolerance <= 0 {
+		return append([]models.TelematicsData(nil), track...)
+	}
+
+	keep := make([]bool, len(track))
+	keep[0], keep[len(track)-1] = true, true
+
+	type span struct{ first, last int }
+	stack := []span{{0, len(track) - 1}}
+	for len(stack) > 0 {
+		s := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		farthest, maxDistance := -1, tolerance
+		for i := s.first + 1; i < s.last; i++ {
+			if d := segmentDistance(track[i], track[s.first], track[s.last]); d > maxDistance {
+				farthest, maxDistance = i, d
+			}
+		}
+		if farthest < 0 {
+			continue
+		}
+		keep[farthest] = true
+		stack = append(stack, span{s.first, farthest}, span{farthest, s.last})
+	}
+
+	var result []models.TelematicsData
+	for i, d := range track {
+		if keep[i] {
+			result = append(result, d)
+		}
+	}
+	return result
+}
+
+// segmentDistance returns the distance in metres from p to the segment
+// between a and b, on the plane tangent to the Earth at a. The error is
+// negligible at the distances between consecutive records.
+func segmentDistance(p, a, b models.TelematicsData) float64 {
+	scale := math.Cos(a.Latitude * math.Pi / 180)
+	project := func(d models.TelematicsData) (float64, float64) {
+		lng := math.Remainder(d.Longitude-a.Longitude, 360)
+		return lng * math.Pi / 180 * scale * models.EarthRadius, (d.Latitude - a.Latitude) * math.Pi / 180 * models.EarthRadius
+	}
+
+	px, py := project(p)
+	bx, by := project(b)
+
+	t := 0.0
+	if l := bx*bx + by*by; l > 0 {
+		t = math.Max(0, math.Min(1, (px*bx+py*by)/l))
+	}
+	return math.Hypot(px-t*bx, py-t*by)
+}
@@ -0,0 +1,39 @@
+package track
+
+import (
+	"telematics-generator/pkg/models"
+	"testing"
+	"time"
+)
+
+func TestSimplify(t *testing.T) {
+	start := time.Date(2023, 7, 12, 10, 0, 0, 0, time.UTC)
+	point := func(i int, lat, lng float64) models.TelematicsData {
+		return models.TelematicsData{VehicleID: 1, Timestamp: start.Add(time.Duration(i) * time.Second), Latitude: lat, Longitude: lng}
+	}
+
+	// Along the equator 0.001 degrees are about 111 metres. The track goes
+	// east with a 5 metre wobble and turns north at the end.
+	track := []models.TelematicsData{
+		point(0, 0, 0),
+		point(1, 0.00004, 0.001),
+		point(2, 0, 0.002),
+		point(3, -0.00004, 0.003),
+		point(4, 0, 0.004),
+		point(5, 0.002, 0.004),
+	}
+
+	got := Simplify(track, 10)
+	if len(got) != 3 || got[0] != track[0] || got[1] != track[4] || got[2] != track[5] {
+		t.Errorf("Simplify() with 10 m tolerance = %v, want the start, the turn and the end", got)
+	}
+
+	// The third record lies on the line between its neighbours.
+	if got := Simplify(track, 1); len(got) != len(track)-1 || got[2] != track[3] {
+		t.Errorf("Simplify() with 1 m tolerance = %v, want every record but the third", got)
+	}
+
+	if got := Simplify(track, 1000); len(got) != 2 || got[0] != track[0] || got[1] != track[5] {
+		t.Errorf("Simplify() with 1 km tolerance = %v, want the start and the end", got)
+	}
+}
@@ -0,0 +1,76 @@
+package track
+
+import (
+	"time"
+
+	"telematics-generator/pkg/models"
+)
+
+// Stats summarizes the records of a vehicle, or of several vehicles after
+// Merge. Distance is in metres along the great circle between consecutive
+// records. The time between consecutive records is idle if the speed of both
+// is at most the idle speed and moving otherwise. A stop is a record at idle
+// speed following a record above it.
+type Stats struct {
+	VehicleID  int
+	Count      int
+	Distance   float64
+	MovingTime time.Duration
+	IdleTime   time.Duration
+	MaxSpeed   int
+	AvgSpeed   float64
+	Stops      int
+}
+
+// Summarize returns the stats of the track, which should be the records of
+// one vehicle sorted by timestamp ascending.
+func Summarize(track []models.TelematicsData, idleSpeed int) Stats {
+	var stats Stats
+	if len(track) == 0 {
+		return stats
+	}
+
+	stats.VehicleID = track[0].VehicleID
+	stats.Count = len(track)
+	speedSum := 0
+	for i, d := range track {
+		speedSum += d.Speed
+		if d.Speed > stats.MaxSpeed {
+			stats.MaxSpeed = d.Speed
+		}
+		if i == 0 {
+			continue
+		}
+
+		prev := track[i-1]
+		stats.Distance += distance(prev.Latitude, prev.Longitude, d.Latitude, d.Longitude)
+		if prev.Speed <= idleSpeed && d.Speed <= idleSpeed {
+			stats.IdleTime += d.Timestamp.Sub(prev.Timestamp)
+		} else {
+			stats.MovingTime += d.Timestamp.Sub(prev.Timestamp)
+		}
+		if prev.Speed > idleSpeed && d.Speed <= idleSpeed {
+			stats.Stops++
+		}
+	}
+	stats.AvgSpeed = float64(speedSum) / float64(stats.Count)
+
+	return stats
+}
+
+// Merge adds the stats of another vehicle. Times and distances are summed and
+// the average speed is weighted by the number of records.
+func (s *Stats) Merge(other Stats) {
+	if other.Count == 0 {
+		return
+	}
+	s.AvgSpeed = (s.AvgSpeed*float64(s.Count) + other.AvgSpeed*float64(other.Count)) / float64(s.Count+other.Count)
+	s.Count += other.Count
+	s.Distance += other.Distance
+	s.MovingTime += other.MovingTime
+	s.IdleTime += other.IdleTime
+	if other.MaxSpeed > s.MaxSpeed {
+		s.MaxSpeed = other.MaxSpeed
+	}
+	s.Stops += other.Stops
+}
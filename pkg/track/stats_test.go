@@ -0,0 +1,53 @@
+package track
+
+import (
+	"math"
+	"telematics-generator/pkg/models"
+	"testing"
+	"time"
+)
+
+func TestSummarize(t *testing.T) {
+	start := time.Date(2023, 7, 12, 10, 0, 0, 0, time.UTC)
+	record := func(seconds, speed int, lng float64) models.TelematicsData {
+		return models.TelematicsData{VehicleID: 3, Timestamp: start.Add(time.Duration(seconds) * time.Second), Speed: speed, Longitude: lng}
+	}
+
+	// 0.001 degrees of longitude on the equator are about 111.2 metres.
+	track := []models.TelematicsData{
+		record(0, 0, 0),
+		record(10, 20, 0.001),
+		record(20, 30, 0.002),
+		record(30, 0, 0.002),
+		record(50, 0, 0.002),
+		record(60, 10, 0.003),
+		record(70, 2, 0.003),
+	}
+
+	stats := Summarize(track, 0)
+	if stats.VehicleID != 3 || stats.Count != 7 || stats.MaxSpeed != 30 || math.Abs(stats.AvgSpeed-62.0/7) > 1e-9 {
+		t.Errorf("Summarize() speeds = %+v", stats)
+	}
+	if math.Abs(stats.Distance-333.6) > 0.5 {
+		t.Errorf("Summarize() distance = %v, want about 333.6 m", stats.Distance)
+	}
+	if stats.MovingTime != 50*time.Second || stats.IdleTime != 20*time.Second || stats.Stops != 1 {
+		t.Errorf("Summarize() = moving %v, idle %v, %d stops, want 50s, 20s and 1 stop", stats.MovingTime, stats.IdleTime, stats.Stops)
+	}
+
+	stats = Summarize(track, 5)
+	if stats.MovingTime != 50*time.Second || stats.IdleTime != 20*time.Second || stats.Stops != 2 {
+		t.Errorf("Summarize() with idle speed 5 = moving %v, idle %v, %d stops, want 50s, 20s and 2 stops", stats.MovingTime, stats.IdleTime, stats.Stops)
+	}
+
+	fleet := Summarize(track[:2], 0)
+	fleet.Merge(Summarize(track[2:], 0))
+	fleet.Merge(Stats{})
+	if fleet.Count != 7 || fleet.MaxSpeed != 30 || math.Abs(fleet.AvgSpeed-62.0/7) > 1e-9 || fleet.Stops != 1 {
+		t.Errorf("Merge() = %+v", fleet)
+	}
+
+	if empty := Summarize(nil, 0); empty != (Stats{}) {
+		t.Errorf("Summarize() of an empty track = %+v, want zero stats", empty)
+	}
+}
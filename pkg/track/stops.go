@@ -0,0 +1,150 @@
+package track
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	geo "github.com/kellydunn/golang-geo"
+	"telematics-generator/pkg/models"
+)
+
+// StopOptions tell what a stop is: the records of a vehicle going at most
+// MaxSpeed and staying within Radius metres of the first of them for at
+// least MinDuration. Stops within PlaceRadius metres of a place are visits
+// of that place.
+type StopOptions struct {
+	MaxSpeed    int
+	Radius      float64
+	MinDuration time.Duration
+	PlaceRadius float64
+}
+
+// Stop is a stop of a vehicle located at the mean position of its records.
+// Place is the index of the place the stop belongs to after ClusterPlaces.
+type Stop struct {
+	VehicleID int
+	Latitude  float64
+	Longitude float64
+	Arrival   time.Time
+	Departure time.Time
+	Count     int
+	Place     int
+}
+
+// Place is a location a vehicle stopped at, at the mean position of its
+// stops.
+type Place struct {
+	VehicleID int
+	Latitude  float64
+	Longitude float64
+	Visits    int
+	Dwell     time.Duration
+}
+
+func (s Stop) Duration() time.Duration {
+	return s.Departure.Sub(s.Arrival)
+}
+
+// Validate checks the options.
+func (o StopOptions) Validate() error {
+	if o.MaxSpeed < 0 {
+		return errors.New("stop speed should not be negative")
+	}
+	if !(o.Radius > 0) {
+		return errors.New("stop radius should be positive")
+	}
+	if o.MinDuration < 0 {
+		return errors.New("stop min duration should not be negative")
+	}
+	if !(o.PlaceRadius > 0) {
+		return errors.New("place radius should be positive")
+	}
+	return nil
+}
+
+// DetectStops returns the stops of the track, which should be the records of
+// one vehicle sorted by timestamp ascending.
+func DetectStops(track []models.TelematicsData, opts StopOptions) []Stop {
+	var stops []Stop
+	for i := 0; i < len(track); {
+		if track[i].Speed > opts.MaxSpeed {
+			i++
+			continue
+		}
+
+		first := track[i]
+		j := i + 1
+		for ; j < len(track) && track[j].Speed <= opts.MaxSpeed && distance(first.Latitude, first.Longitude, track[j].Latitude, track[j].Longitude) <= opts.Radius; j++ {
+		}
+		if track[j-1].Timestamp.Sub(first.Timestamp) < opts.MinDuration {
+			i++
+			continue
+		}
+
+		stop := Stop{VehicleID: first.VehicleID, Arrival: first.Timestamp, Departure: track[j-1].Timestamp, Count: j - i}
+		for _, d := range track[i:j] {
+			stop.Latitude += d.Latitude / float64(stop.Count)
+			stop.Longitude += d.Longitude / float64(stop.Count)
+		}
+		stops = append(stops, stop)
+		i = j
+	}
+	return stops
+}
+
+// ClusterPlaces groups the stops of one vehicle into places, assigning every
+// stop to the nearest place within the place radius or to a new one, and
+// returns the places ordered by visits and dwell time, most visited first.
+func ClusterPlaces(stops []Stop, opts StopOptions) []Place {
+	var places []Place
+	for i := range stops {
+		s := &stops[i]
+		s.Place = -1
+		nearest := opts.PlaceRadius
+		for p := range places {
+			if d := distance(places[p].Latitude, places[p].Longitude, s.Latitude, s.Longitude); d <= nearest {
+				s.Place, nearest = p, d
+			}
+		}
+		if s.Place < 0 {
+			s.Place = len(places)
+			places = append(places, Place{VehicleID: s.VehicleID})
+		}
+
+		p := &places[s.Place]
+		p.Visits++
+		p.Latitude += (s.Latitude - p.Latitude) / float64(p.Visits)
+		p.Longitude += (s.Longitude - p.Longitude) / float64(p.Visits)
+		p.Dwell += s.Duration()
+	}
+
+	order := make([]int, len(places))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		a, b := places[order[i]], places[order[j]]
+		if a.Visits != b.Visits {
+			return a.Visits > b.Visits
+		}
+		return a.Dwell > b.Dwell
+	})
+
+	index := make([]int, len(places))
+	sorted := make([]Place, len(places))
+	for i, p := range order {
+		index[p] = i
+		sorted[i] = places[p]
+	}
+	for i := range stops {
+		stops[i].Place = index[stops[i].Place]
+	}
+	return sorted
+}
+
+// distance returns the great circle distance in metres between two points
+// given in degrees.
+func distance(lat1, lng1, lat2, lng2 float64) float64 {
+	return geo.NewPoint(lat1, lng1).GreatCircleDistance(geo.NewPoint(lat2, lng2)) * 1000
+}
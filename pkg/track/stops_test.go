@@ -0,0 +1,71 @@
+package track
+
+import (
+	"math"
+	"telematics-generator/pkg/models"
+	"testing"
+	"time"
+)
+
+func TestDetectStops(t *testing.T) {
+	start := time.Date(2023, 7, 12, 10, 0, 0, 0, time.UTC)
+	record := func(minutes, speed int, lat, lng float64) models.TelematicsData {
+		return models.TelematicsData{VehicleID: 4, Timestamp: start.Add(time.Duration(minutes) * time.Minute), Speed: speed, Latitude: lat, Longitude: lng}
+	}
+	opts := StopOptions{MaxSpeed: 3, Radius: 50, MinDuration: 5 * time.Minute, PlaceRadius: 200}
+
+	// 0.0001 degrees are about 11 metres. The vehicle stops at the depot,
+	// briefly at a crossing, at a customer, where it creeps 100 metres
+	// between two stops, and at the depot again.
+	track := []models.TelematicsData{
+		record(0, 0, 0, 0),
+		record(5, 2, 0.0001, 0),
+		record(10, 0, 0, 0.0001),
+		record(11, 60, 0.01, 0),
+		record(12, 0, 0.02, 0),
+		record(13, 0, 0.02, 0),
+		record(14, 50, 0.03, 0),
+		record(15, 0, 0.05, 0),
+		record(25, 1, 0.05, 0),
+		record(26, 1, 0.0509, 0),
+		record(40, 0, 0.0509, 0),
+		record(50, 70, 0.01, 0),
+		record(60, 0, 0.0003, 0),
+		record(70, 0, 0.0003, 0),
+	}
+
+	stops := DetectStops(track, opts)
+	want := []struct {
+		arrival, departure, count int
+	}{{0, 10, 3}, {15, 25, 2}, {26, 40, 2}, {60, 70, 2}}
+	if len(stops) != len(want) {
+		t.Fatalf("DetectStops() = %+v, want %d stops", stops, len(want))
+	}
+	for i, w := range want {
+		s := stops[i]
+		if !s.Arrival.Equal(start.Add(time.Duration(w.arrival)*time.Minute)) ||
+			!s.Departure.Equal(start.Add(time.Duration(w.departure)*time.Minute)) || s.Count != w.count || s.VehicleID != 4 {
+			t.Errorf("DetectStops()[%d] = %+v, want from %d to %d min with %d records", i, s, w.arrival, w.departure, w.count)
+		}
+	}
+	if math.Abs(stops[0].Latitude-0.0001/3) > 1e-12 || math.Abs(stops[0].Longitude-0.0001/3) > 1e-12 {
+		t.Errorf("DetectStops()[0] at %v, %v, want the mean of its records", stops[0].Latitude, stops[0].Longitude)
+	}
+
+	places := ClusterPlaces(stops, opts)
+	if len(places) != 2 {
+		t.Fatalf("ClusterPlaces() = %+v, want the depot and the customer", places)
+	}
+	// Both places have two visits, the customer has the longer dwell.
+	if places[0].Visits != 2 || places[0].Dwell != 24*time.Minute || math.Abs(places[0].Latitude-0.05045) > 1e-9 {
+		t.Errorf("ClusterPlaces()[0] = %+v, want the customer", places[0])
+	}
+	if places[1].Visits != 2 || places[1].Dwell != 20*time.Minute || places[1].VehicleID != 4 {
+		t.Errorf("ClusterPlaces()[1] = %+v, want the depot", places[1])
+	}
+	for i, place := range []int{1, 0, 0, 1} {
+		if stops[i].Place != place {
+			t.Errorf("stop %d is at place %d, want %d", i, stops[i].Place, place)
+		}
+	}
+}
@@ -0,0 +1,205 @@
+package track
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"telematics-generator/pkg/models"
+)
+
+// TripOptions tell where trips start and end. The records carry no ignition
+// state, so a trip starts when the vehicle moves faster than IdleSpeed and
+// ends once it has stood still for at least MinStop.
+type TripOptions struct {
+	IdleSpeed int
+	MinStop   time.Duration
+}
+
+// DefaultTripOptions are used unless other trip settings are configured.
+var DefaultTripOptions = TripOptions{IdleSpeed: 5, MinStop: 3 * time.Minute}
+
+// TripSummary describes a trip from the record it starts with, the last one
+// the vehicle was parked at or the first moving one, to the first record of
+// the stop ending it. Distance is in metres along the great circle between
+// consecutive records. The end of an ongoing trip is its latest record.
+type TripSummary struct {
+	VehicleID int
+	Start     models.TelematicsData
+	End       models.TelematicsData
+	Distance  float64
+	MaxSpeed  int
+	Ongoing   bool
+}
+
+// Trip is a trip with all its records sorted by timestamp ascending.
+type Trip struct {
+	TripSummary
+	Points []models.TelematicsData
+}
+
+type TripEventType int
+
+const (
+	TripStarted TripEventType = iota
+	TripEnded
+)
+
+type TripEvent struct {
+	Type TripEventType
+	Trip TripSummary
+}
+
+// ID identifies the trip by the vehicle and the start timestamp.
+func (t TripSummary) ID() string {
+	return fmt.Sprintf("%d-%d", t.VehicleID, t.Start.Timestamp.UnixNano())
+}
+
+func (t TripSummary) Duration() time.Duration {
+	return t.End.Timestamp.Sub(t.Start.Timestamp)
+}
+
+// ParseTripID returns the vehicle and the start time of the trip with the ID.
+func ParseTripID(id string) (int, time.Time, error) {
+	invalid := fmt.Errorf("trip id %q should have the form <vehicle id>-<start timestamp>", id)
+
+	vehicle, start, ok := strings.Cut(id, "-")
+	if !ok {
+		return 0, time.Time{}, invalid
+	}
+	vehicleID, err := strconv.Atoi(vehicle)
+	if err != nil {
+		return 0, time.Time{}, invalid
+	}
+	ts, err := strconv.ParseInt(start, 10, 64)
+	if err != nil {
+		return 0, time.Time{}, invalid
+	}
+	return vehicleID, time.Unix(0, ts), nil
+}
+
+// Validate checks the options.
+func (o TripOptions) Validate() error {
+	if o.IdleSpeed < 0 {
+		return errors.New("idle speed should not be negative")
+	}
+	if o.MinStop < 0 {
+		return errors.New("min stop should not be negative")
+	}
+	return nil
+}
+
+// SplitTrips splits the track into trips. The track should be the records of
+// one vehicle sorted by timestamp ascending; a trip still going on at the
+// last record is returned as ongoing.
+func SplitTrips(track []models.TelematicsData, opts TripOptions) []Trip {
+	var trips []Trip
+	t := tripTracker{opts: opts}
+	for _, d := range track {
+		e := t.add(d)
+		if e != nil && e.Type == TripEnded {
+			trips = append(trips, Trip{TripSummary: e.Trip, Points: track[t.start : t.stop+1]})
+		}
+	}
+	if t.moving {
+		trips = append(trips, Trip{TripSummary: t.trip, Points: track[t.start:]})
+	}
+	return trips
+}
+
+// TripDetector follows the records of every vehicle as they arrive and
+// reports the trips starting and ending. Records older than the latest one of
+// the vehicle are ignored.
+type TripDetector struct {
+	opts     TripOptions
+	mx       sync.Mutex
+	vehicles map[int]*tripTracker
+}
+
+func NewTripDetector(opts TripOptions) *TripDetector {
+	return &TripDetector{opts: opts, vehicles: make(map[int]*tripTracker)}
+}
+
+// Add accounts the record and returns the event it causes, if any.
+func (d *TripDetector) Add(telematicsData models.TelematicsData) (TripEvent, bool) {
+	d.mx.Lock()
+	defer d.mx.Unlock()
+
+	t, ok := d.vehicles[telematicsData.VehicleID]
+	if !ok {
+		t = &tripTracker{opts: d.opts}
+		d.vehicles[telematicsData.VehicleID] = t
+	}
+	if t.count > 0 && telematicsData.Timestamp.Before(t.prev.Timestamp) {
+		return TripEvent{}, false
+	}
+
+	e := t.add(telematicsData)
+	if e == nil {
+		return TripEvent{}, false
+	}
+	return *e, true
+}
+
+// tripTracker finds the trips of one vehicle. start and stop are the
+// positions of the first record of the current trip and of its stop among
+// the records added.
+type tripTracker struct {
+	opts    TripOptions
+	count   int
+	prev    models.TelematicsData
+	moving  bool
+	stopped bool
+	start   int
+	stop    int
+	trip    TripSummary
+	atStop  TripSummary
+}
+
+func (t *tripTracker) add(d models.TelematicsData) *TripEvent {
+	defer func() {
+		t.prev = d
+		t.count++
+	}()
+
+	idle := d.Speed <= t.opts.IdleSpeed
+	switch {
+	case !t.moving && !idle:
+		t.moving, t.stopped = true, false
+		t.start = t.count
+		t.trip = TripSummary{VehicleID: d.VehicleID, Start: d, End: d, MaxSpeed: d.Speed, Ongoing: true}
+		if t.count > 0 {
+			t.start--
+			t.trip.Start, t.trip.End, t.trip.MaxSpeed = t.prev, t.prev, t.prev.Speed
+			t.trip.extend(d)
+		}
+		return &TripEvent{Type: TripStarted, Trip: t.trip}
+	case t.moving && idle:
+		t.trip.extend(d)
+		if !t.stopped {
+			t.stopped = true
+			t.stop = t.count
+			t.atStop = t.trip
+		}
+		if d.Timestamp.Sub(t.atStop.End.Timestamp) >= t.opts.MinStop {
+			t.moving = false
+			t.atStop.Ongoing = false
+			return &TripEvent{Type: TripEnded, Trip: t.atStop}
+		}
+	case t.moving:
+		t.trip.extend(d)
+		t.stopped = false
+	}
+	return nil
+}
+
+func (t *TripSummary) extend(d models.TelematicsData) {
+	t.Distance += distance(t.End.Latitude, t.End.Longitude, d.Latitude, d.Longitude)
+	if d.Speed > t.MaxSpeed {
+		t.MaxSpeed = d.Speed
+	}
+	t.End = d
+}
@@ -0,0 +1,77 @@
+package track
+
+import (
+	"math"
+	"strconv"
+	"telematics-generator/pkg/models"
+	"testing"
+	"time"
+)
+
+func TestSplitTrips(t *testing.T) {
+	start := time.Date(2023, 7, 12, 10, 0, 0, 0, time.UTC)
+	record := func(minutes, speed int, lng float64) models.TelematicsData {
+		return models.TelematicsData{VehicleID: 2, Timestamp: start.Add(time.Duration(minutes) * time.Minute), Speed: speed, Longitude: lng}
+	}
+
+	// Parked, a trip with a short stop in the middle, a long stop and a
+	// second trip still going on.
+	track := []models.TelematicsData{
+		record(0, 0, 0),
+		record(1, 0, 0),
+		record(2, 50, 0.001),
+		record(3, 2, 0.002),
+		record(4, 60, 0.003),
+		record(5, 0, 0.004),
+		record(6, 0, 0.004),
+		record(10, 0, 0.004),
+		record(11, 40, 0.005),
+	}
+	opts := TripOptions{IdleSpeed: 5, MinStop: 3 * time.Minute}
+
+	trips := SplitTrips(track, opts)
+	if len(trips) != 2 {
+		t.Fatalf("SplitTrips() = %v, want 2 trips", trips)
+	}
+
+	first := trips[0]
+	if first.Ongoing || first.ID() != "2-"+strconv.FormatInt(start.Add(time.Minute).UnixNano(), 10) ||
+		!first.End.Timestamp.Equal(start.Add(5*time.Minute)) || first.Duration() != 4*time.Minute ||
+		first.MaxSpeed != 60 || len(first.Points) != 5 || math.Abs(first.Distance-444.8) > 0.5 {
+		t.Errorf("SplitTrips() first trip = %+v", first.TripSummary)
+	}
+
+	second := trips[1]
+	if !second.Ongoing || !second.Start.Timestamp.Equal(start.Add(10*time.Minute)) ||
+		!second.End.Timestamp.Equal(start.Add(11*time.Minute)) || len(second.Points) != 2 {
+		t.Errorf("SplitTrips() second trip = %+v", second.TripSummary)
+	}
+
+	vehicleID, tripStart, err := ParseTripID(first.ID())
+	if err != nil || vehicleID != 2 || !tripStart.Equal(first.Start.Timestamp) {
+		t.Errorf("ParseTripID(%v) = %v, %v, %v", first.ID(), vehicleID, tripStart, err)
+	}
+	for _, id := range []string{"", "2", "x-1", "2-x"} {
+		if _, _, err := ParseTripID(id); err == nil {
+			t.Errorf("ParseTripID(%q) error = nil, want an error", id)
+		}
+	}
+
+	// The live detector reports the same trips.
+	detector := NewTripDetector(opts)
+	var events []TripEvent
+	for _, d := range track {
+		if e, ok := detector.Add(d); ok {
+			events = append(events, e)
+		}
+	}
+	if _, ok := detector.Add(record(9, 80, 0)); ok {
+		t.Errorf("TripDetector.Add() of a late record reported an event")
+	}
+	if len(events) != 3 || events[0].Type != TripStarted || events[1].Type != TripEnded || events[2].Type != TripStarted {
+		t.Fatalf("TripDetector events = %+v, want start, end and start", events)
+	}
+	if events[0].Trip.ID() != first.ID() || events[1].Trip != first.TripSummary || events[2].Trip.ID() != second.ID() {
+		t.Errorf("TripDetector events = %+v, want the trips %+v and %+v", events, first.TripSummary, second.TripSummary)
+	}
+}
@@ -9,7 +9,9 @@ package protobuf
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	durationpb "google.golang.org/protobuf/types/known/durationpb"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	fieldmaskpb "google.golang.org/protobuf/types/known/fieldmaskpb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -21,6 +23,294 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type Resolution int32
+
+const (
+	Resolution_RESOLUTION_RAW    Resolution = 0
+	Resolution_RESOLUTION_MINUTE Resolution = 1
+	Resolution_RESOLUTION_HOUR   Resolution = 2
+)
+
+// Enum value maps for Resolution.
+var (
+	Resolution_name = map[int32]string{
+		0: "RESOLUTION_RAW",
+		1: "RESOLUTION_MINUTE",
+		2: "RESOLUTION_HOUR",
+	}
+	Resolution_value = map[string]int32{
+		"RESOLUTION_RAW":    0,
+		"RESOLUTION_MINUTE": 1,
+		"RESOLUTION_HOUR":   2,
+	}
+)
+
+func (x Resolution) Enum() *Resolution {
+	p := new(Resolution)
+	*p = x
+	return p
+}
+
+func (x Resolution) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Resolution) Descriptor() protoreflect.EnumDescriptor {
+	return file_protobuf_telematics_data_proto_enumTypes[0].Descriptor()
+}
+
+func (Resolution) Type() protoreflect.EnumType {
+	return &file_protobuf_telematics_data_proto_enumTypes[0]
+}
+
+func (x Resolution) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Resolution.Descriptor instead.
+func (Resolution) EnumDescriptor() ([]byte, []int) {
+	return file_protobuf_telematics_data_proto_rawDescGZIP(), []int{0}
+}
+
+type Order int32
+
+const (
+	Order_ORDER_DESC Order = 0
+	Order_ORDER_ASC  Order = 1
+)
+
+// Enum value maps for Order.
+var (
+	Order_name = map[int32]string{
+		0: "ORDER_DESC",
+		1: "ORDER_ASC",
+	}
+	Order_value = map[string]int32{
+		"ORDER_DESC": 0,
+		"ORDER_ASC":  1,
+	}
+)
+
+func (x Order) Enum() *Order {
+	p := new(Order)
+	*p = x
+	return p
+}
+
+func (x Order) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Order) Descriptor() protoreflect.EnumDescriptor {
+	return file_protobuf_telematics_data_proto_enumTypes[1].Descriptor()
+}
+
+func (Order) Type() protoreflect.EnumType {
+	return &file_protobuf_telematics_data_proto_enumTypes[1]
+}
+
+func (x Order) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Order.Descriptor instead.
+func (Order) EnumDescriptor() ([]byte, []int) {
+	return file_protobuf_telematics_data_proto_rawDescGZIP(), []int{1}
+}
+
+type TripEventType int32
+
+const (
+	TripEventType_TRIP_EVENT_STARTED TripEventType = 0
+	TripEventType_TRIP_EVENT_ENDED   TripEventType = 1
+)
+
+// Enum value maps for TripEventType.
+var (
+	TripEventType_name = map[int32]string{
+		0: "TRIP_EVENT_STARTED",
+		1: "TRIP_EVENT_ENDED",
+	}
+	TripEventType_value = map[string]int32{
+		"TRIP_EVENT_STARTED": 0,
+		"TRIP_EVENT_ENDED":   1,
+	}
+)
+
+func (x TripEventType) Enum() *TripEventType {
+	p := new(TripEventType)
+	*p = x
+	return p
+}
+
+func (x TripEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TripEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_protobuf_telematics_data_proto_enumTypes[2].Descriptor()
+}
+
+func (TripEventType) Type() protoreflect.EnumType {
+	return &file_protobuf_telematics_data_proto_enumTypes[2]
+}
+
+func (x TripEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TripEventType.Descriptor instead.
+func (TripEventType) EnumDescriptor() ([]byte, []int) {
+	return file_protobuf_telematics_data_proto_rawDescGZIP(), []int{2}
+}
+
+type GridType int32
+
+const (
+	GridType_GRID_GEOHASH GridType = 0
+	GridType_GRID_SQUARE  GridType = 1
+	GridType_GRID_HEXAGON GridType = 2
+)
+
+// Enum value maps for GridType.
+var (
+	GridType_name = map[int32]string{
+		0: "GRID_GEOHASH",
+		1: "GRID_SQUARE",
+		2: "GRID_HEXAGON",
+	}
+	GridType_value = map[string]int32{
+		"GRID_GEOHASH": 0,
+		"GRID_SQUARE":  1,
+		"GRID_HEXAGON": 2,
+	}
+)
+
+func (x GridType) Enum() *GridType {
+	p := new(GridType)
+	*p = x
+	return p
+}
+
+func (x GridType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GridType) Descriptor() protoreflect.EnumDescriptor {
+	return file_protobuf_telematics_data_proto_enumTypes[3].Descriptor()
+}
+
+func (GridType) Type() protoreflect.EnumType {
+	return &file_protobuf_telematics_data_proto_enumTypes[3]
+}
+
+func (x GridType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GridType.Descriptor instead.
+func (GridType) EnumDescriptor() ([]byte, []int) {
+	return file_protobuf_telematics_data_proto_rawDescGZIP(), []int{3}
+}
+
+type CommandType int32
+
+const (
+	CommandType_COMMAND_SET_INTERVAL     CommandType = 0
+	CommandType_COMMAND_IMMOBILISE       CommandType = 1
+	CommandType_COMMAND_REQUEST_POSITION CommandType = 2
+	CommandType_COMMAND_SET_OUTPUT       CommandType = 3
+)
+
+// Enum value maps for CommandType.
+var (
+	CommandType_name = map[int32]string{
+		0: "COMMAND_SET_INTERVAL",
+		1: "COMMAND_IMMOBILISE",
+		2: "COMMAND_REQUEST_POSITION",
+		3: "COMMAND_SET_OUTPUT",
+	}
+	CommandType_value = map[string]int32{
+		"COMMAND_SET_INTERVAL":     0,
+		"COMMAND_IMMOBILISE":       1,
+		"COMMAND_REQUEST_POSITION": 2,
+		"COMMAND_SET_OUTPUT":       3,
+	}
+)
+
+func (x CommandType) Enum() *CommandType {
+	p := new(CommandType)
+	*p = x
+	return p
+}
+
+func (x CommandType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CommandType) Descriptor() protoreflect.EnumDescriptor {
+	return file_protobuf_telematics_data_proto_enumTypes[4].Descriptor()
+}
+
+func (CommandType) Type() protoreflect.EnumType {
+	return &file_protobuf_telematics_data_proto_enumTypes[4]
+}
+
+func (x CommandType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CommandType.Descriptor instead.
+func (CommandType) EnumDescriptor() ([]byte, []int) {
+	return file_protobuf_telematics_data_proto_rawDescGZIP(), []int{4}
+}
+
+type CommandStatus int32
+
+const (
+	CommandStatus_COMMAND_STATUS_APPLIED CommandStatus = 0
+	CommandStatus_COMMAND_STATUS_FAILED  CommandStatus = 1
+)
+
+// Enum value maps for CommandStatus.
+var (
+	CommandStatus_name = map[int32]string{
+		0: "COMMAND_STATUS_APPLIED",
+		1: "COMMAND_STATUS_FAILED",
+	}
+	CommandStatus_value = map[string]int32{
+		"COMMAND_STATUS_APPLIED": 0,
+		"COMMAND_STATUS_FAILED":  1,
+	}
+)
+
+func (x CommandStatus) Enum() *CommandStatus {
+	p := new(CommandStatus)
+	*p = x
+	return p
+}
+
+func (x CommandStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CommandStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_protobuf_telematics_data_proto_enumTypes[5].Descriptor()
+}
+
+func (CommandStatus) Type() protoreflect.EnumType {
+	return &file_protobuf_telematics_data_proto_enumTypes[5]
+}
+
+func (x CommandStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CommandStatus.Descriptor instead.
+func (CommandStatus) EnumDescriptor() ([]byte, []int) {
+	return file_protobuf_telematics_data_proto_rawDescGZIP(), []int{5}
+}
+
 type TelematicsDataProto struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -31,6 +321,8 @@ type TelematicsDataProto struct {
 	Speed     int32   `protobuf:"varint,3,opt,name=speed,proto3" json:"speed,omitempty"`
 	Latitude  float64 `protobuf:"fixed64,4,opt,name=latitude,proto3" json:"latitude,omitempty"`
 	Longitude float64 `protobuf:"fixed64,5,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	// Set on aggregated records, which carry the last record of the bucket.
+	Rollup *Rollup `protobuf:"bytes,6,opt,name=rollup,proto3" json:"rollup,omitempty"`
 }
 
 func (x *TelematicsDataProto) Reset() {
@@ -100,19 +392,135 @@ func (x *TelematicsDataProto) GetLongitude() float64 {
 	return 0
 }
 
+func (x *TelematicsDataProto) GetRollup() *Rollup {
+	if x != nil {
+		return x.Rollup
+	}
+	return nil
+}
+
+type Rollup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BucketStart    int64      `protobuf:"varint,1,opt,name=bucket_start,json=bucketStart,proto3" json:"bucket_start,omitempty"`
+	Resolution     Resolution `protobuf:"varint,2,opt,name=resolution,proto3,enum=proto.Resolution" json:"resolution,omitempty"`
+	Count          int32      `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	MinSpeed       int32      `protobuf:"varint,4,opt,name=min_speed,json=minSpeed,proto3" json:"min_speed,omitempty"`
+	AvgSpeed       float64    `protobuf:"fixed64,5,opt,name=avg_speed,json=avgSpeed,proto3" json:"avg_speed,omitempty"`
+	MaxSpeed       int32      `protobuf:"varint,6,opt,name=max_speed,json=maxSpeed,proto3" json:"max_speed,omitempty"`
+	DistanceMeters float64    `protobuf:"fixed64,7,opt,name=distance_meters,json=distanceMeters,proto3" json:"distance_meters,omitempty"`
+}
+
+func (x *Rollup) Reset() {
+	*x = Rollup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_telematics_data_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Rollup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rollup) ProtoMessage() {}
+
+func (x *Rollup) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_telematics_data_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rollup.ProtoReflect.Descriptor instead.
+func (*Rollup) Descriptor() ([]byte, []int) {
+	return file_protobuf_telematics_data_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Rollup) GetBucketStart() int64 {
+	if x != nil {
+		return x.BucketStart
+	}
+	return 0
+}
+
+func (x *Rollup) GetResolution() Resolution {
+	if x != nil {
+		return x.Resolution
+	}
+	return Resolution_RESOLUTION_RAW
+}
+
+func (x *Rollup) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *Rollup) GetMinSpeed() int32 {
+	if x != nil {
+		return x.MinSpeed
+	}
+	return 0
+}
+
+func (x *Rollup) GetAvgSpeed() float64 {
+	if x != nil {
+		return x.AvgSpeed
+	}
+	return 0
+}
+
+func (x *Rollup) GetMaxSpeed() int32 {
+	if x != nil {
+		return x.MaxSpeed
+	}
+	return 0
+}
+
+func (x *Rollup) GetDistanceMeters() float64 {
+	if x != nil {
+		return x.DistanceMeters
+	}
+	return 0
+}
+
 type RangeDataRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FromTimestamp int64 `protobuf:"varint,1,opt,name=from_timestamp,json=fromTimestamp,proto3" json:"from_timestamp,omitempty"`
-	ToTimestamp   int64 `protobuf:"varint,2,opt,name=to_timestamp,json=toTimestamp,proto3" json:"to_timestamp,omitempty"`
+	FromTimestamp int64      `protobuf:"varint,1,opt,name=from_timestamp,json=fromTimestamp,proto3" json:"from_timestamp,omitempty"`
+	ToTimestamp   int64      `protobuf:"varint,2,opt,name=to_timestamp,json=toTimestamp,proto3" json:"to_timestamp,omitempty"`
+	Resolution    Resolution `protobuf:"varint,3,opt,name=resolution,proto3,enum=proto.Resolution" json:"resolution,omitempty"`
+	VehicleIds    []int32    `protobuf:"varint,4,rep,packed,name=vehicle_ids,json=vehicleIds,proto3" json:"vehicle_ids,omitempty"`
+	// At most limit records are sent, 0 sends all of them. If records are left,
+	// the next-page-token trailer holds the page_token of the next page.
+	Limit int32 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	Order Order `protobuf:"varint,6,opt,name=order,proto3,enum=proto.Order" json:"order,omitempty"`
+	// Top-level fields of TelematicsDataProto to fill, all of them if empty.
+	Fields    *fieldmaskpb.FieldMask `protobuf:"bytes,7,opt,name=fields,proto3" json:"fields,omitempty"`
+	PageToken string                 `protobuf:"bytes,8,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+	// Raw records of every vehicle are either resampled to positions at every
+	// multiple of resample_interval or simplified with Douglas-Peucker to stay
+	// within simplify_tolerance_meters of the original track.
+	ResampleInterval        *durationpb.Duration `protobuf:"bytes,9,opt,name=resample_interval,json=resampleInterval,proto3" json:"resample_interval,omitempty"`
+	SimplifyToleranceMeters float64              `protobuf:"fixed64,10,opt,name=simplify_tolerance_meters,json=simplifyToleranceMeters,proto3" json:"simplify_tolerance_meters,omitempty"`
 }
 
 func (x *RangeDataRequest) Reset() {
 	*x = RangeDataRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_protobuf_telematics_data_proto_msgTypes[1]
+		mi := &file_protobuf_telematics_data_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -125,7 +533,7 @@ func (x *RangeDataRequest) String() string {
 func (*RangeDataRequest) ProtoMessage() {}
 
 func (x *RangeDataRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_protobuf_telematics_data_proto_msgTypes[1]
+	mi := &file_protobuf_telematics_data_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -138,7 +546,7 @@ func (x *RangeDataRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RangeDataRequest.ProtoReflect.Descriptor instead.
 func (*RangeDataRequest) Descriptor() ([]byte, []int) {
-	return file_protobuf_telematics_data_proto_rawDescGZIP(), []int{1}
+	return file_protobuf_telematics_data_proto_rawDescGZIP(), []int{2}
 }
 
 func (x *RangeDataRequest) GetFromTimestamp() int64 {
@@ -155,95 +563,4228 @@ func (x *RangeDataRequest) GetToTimestamp() int64 {
 	return 0
 }
 
-var File_protobuf_telematics_data_proto protoreflect.FileDescriptor
+func (x *RangeDataRequest) GetResolution() Resolution {
+	if x != nil {
+		return x.Resolution
+	}
+	return Resolution_RESOLUTION_RAW
+}
 
-var file_protobuf_telematics_data_proto_rawDesc = []byte{
-	0x0a, 0x1e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x65, 0x6c, 0x65, 0x6d,
-	0x61, 0x74, 0x69, 0x63, 0x73, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x12, 0x05, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa2, 0x01, 0x0a, 0x13, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x61, 0x74,
-	0x69, 0x63, 0x73, 0x44, 0x61, 0x74, 0x61, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1d, 0x0a, 0x0a,
-	0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x09, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
-	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x70, 0x65,
-	0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x70, 0x65, 0x65, 0x64, 0x12,
-	0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x01, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c,
-	0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09,
-	0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x22, 0x5c, 0x0a, 0x10, 0x52, 0x61, 0x6e,
-	0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a,
-	0x0e, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x6f, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x32, 0xa3, 0x01, 0x0a, 0x15, 0x54, 0x65, 0x6c, 0x65,
-	0x6d, 0x61, 0x74, 0x69, 0x63, 0x73, 0x44, 0x61, 0x74, 0x61, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x12, 0x43, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x44, 0x61,
-	0x74, 0x61, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2e, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x73, 0x44, 0x61, 0x74,
-	0x61, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x45, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e,
-	0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52,
-	0x61, 0x6e, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x61, 0x74, 0x69,
-	0x63, 0x73, 0x44, 0x61, 0x74, 0x61, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x30, 0x01, 0x42, 0x1f, 0x5a,
-	0x1d, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x73, 0x2d, 0x67, 0x65, 0x6e, 0x65,
-	0x72, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *RangeDataRequest) GetVehicleIds() []int32 {
+	if x != nil {
+		return x.VehicleIds
+	}
+	return nil
 }
 
-var (
-	file_protobuf_telematics_data_proto_rawDescOnce sync.Once
-	file_protobuf_telematics_data_proto_rawDescData = file_protobuf_telematics_data_proto_rawDesc
-)
+func (x *RangeDataRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
 
-func file_protobuf_telematics_data_proto_rawDescGZIP() []byte {
-	file_protobuf_telematics_data_proto_rawDescOnce.Do(func() {
-		file_protobuf_telematics_data_proto_rawDescData = protoimpl.X.CompressGZIP(file_protobuf_telematics_data_proto_rawDescData)
-	})
-	return file_protobuf_telematics_data_proto_rawDescData
+func (x *RangeDataRequest) GetOrder() Order {
+	if x != nil {
+		return x.Order
+	}
+	return Order_ORDER_DESC
 }
 
-var file_protobuf_telematics_data_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
-var file_protobuf_telematics_data_proto_goTypes = []interface{}{
-	(*TelematicsDataProto)(nil), // 0: proto.TelematicsDataProto
-	(*RangeDataRequest)(nil),    // 1: proto.RangeDataRequest
-	(*emptypb.Empty)(nil),       // 2: google.protobuf.Empty
+func (x *RangeDataRequest) GetFields() *fieldmaskpb.FieldMask {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
 }
-var file_protobuf_telematics_data_proto_depIdxs = []int32{
-	2, // 0: proto.TelematicsDataService.GetLatestData:input_type -> google.protobuf.Empty
-	1, // 1: proto.TelematicsDataService.GetRangeData:input_type -> proto.RangeDataRequest
-	0, // 2: proto.TelematicsDataService.GetLatestData:output_type -> proto.TelematicsDataProto
-	0, // 3: proto.TelematicsDataService.GetRangeData:output_type -> proto.TelematicsDataProto
-	2, // [2:4] is the sub-list for method output_type
-	0, // [0:2] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+
+func (x *RangeDataRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
 }
 
-func init() { file_protobuf_telematics_data_proto_init() }
-func file_protobuf_telematics_data_proto_init() {
-	if File_protobuf_telematics_data_proto != nil {
-		return
+func (x *RangeDataRequest) GetResampleInterval() *durationpb.Duration {
+	if x != nil {
+		return x.ResampleInterval
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_protobuf_telematics_data_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TelematicsDataProto); i {
-			case 0:
-				return &v.state
-			case 1:
-				return &v.sizeCache
-			case 2:
-				return &v.unknownFields
-			default:
-				return nil
-			}
-		}
-		file_protobuf_telematics_data_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RangeDataRequest); i {
+	return nil
+}
+
+func (x *RangeDataRequest) GetSimplifyToleranceMeters() float64 {
+	if x != nil {
+		return x.SimplifyToleranceMeters
+	}
+	return 0
+}
+
+type GeoPoint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Latitude  float64 `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude float64 `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+}
+
+func (x *GeoPoint) Reset() {
+	*x = GeoPoint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_telematics_data_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GeoPoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeoPoint) ProtoMessage() {}
+
+func (x *GeoPoint) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_telematics_data_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeoPoint.ProtoReflect.Descriptor instead.
+func (*GeoPoint) Descriptor() ([]byte, []int) {
+	return file_protobuf_telematics_data_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GeoPoint) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *GeoPoint) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+// If min.longitude is greater than max.longitude, the box crosses the antimeridian.
+type BoundingBox struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Min *GeoPoint `protobuf:"bytes,1,opt,name=min,proto3" json:"min,omitempty"`
+	Max *GeoPoint `protobuf:"bytes,2,opt,name=max,proto3" json:"max,omitempty"`
+}
+
+func (x *BoundingBox) Reset() {
+	*x = BoundingBox{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_telematics_data_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BoundingBox) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BoundingBox) ProtoMessage() {}
+
+func (x *BoundingBox) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_telematics_data_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BoundingBox.ProtoReflect.Descriptor instead.
+func (*BoundingBox) Descriptor() ([]byte, []int) {
+	return file_protobuf_telematics_data_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BoundingBox) GetMin() *GeoPoint {
+	if x != nil {
+		return x.Min
+	}
+	return nil
+}
+
+func (x *BoundingBox) GetMax() *GeoPoint {
+	if x != nil {
+		return x.Max
+	}
+	return nil
+}
+
+type Polygon struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Points []*GeoPoint `protobuf:"bytes,1,rep,name=points,proto3" json:"points,omitempty"`
+}
+
+func (x *Polygon) Reset() {
+	*x = Polygon{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_telematics_data_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Polygon) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Polygon) ProtoMessage() {}
+
+func (x *Polygon) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_telematics_data_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Polygon.ProtoReflect.Descriptor instead.
+func (*Polygon) Descriptor() ([]byte, []int) {
+	return file_protobuf_telematics_data_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *Polygon) GetPoints() []*GeoPoint {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+type Circle struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Center       *GeoPoint `protobuf:"bytes,1,opt,name=center,proto3" json:"center,omitempty"`
+	RadiusMeters float64   `protobuf:"fixed64,2,opt,name=radius_meters,json=radiusMeters,proto3" json:"radius_meters,omitempty"`
+}
+
+func (x *Circle) Reset() {
+	*x = Circle{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_telematics_data_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Circle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Circle) ProtoMessage() {}
+
+func (x *Circle) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_telematics_data_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Circle.ProtoReflect.Descriptor instead.
+func (*Circle) Descriptor() ([]byte, []int) {
+	return file_protobuf_telematics_data_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Circle) GetCenter() *GeoPoint {
+	if x != nil {
+		return x.Center
+	}
+	return nil
+}
+
+func (x *Circle) GetRadiusMeters() float64 {
+	if x != nil {
+		return x.RadiusMeters
+	}
+	return 0
+}
+
+type AreaDataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromTimestamp int64 `protobuf:"varint,1,opt,name=from_timestamp,json=fromTimestamp,proto3" json:"from_timestamp,omitempty"`
+	ToTimestamp   int64 `protobuf:"varint,2,opt,name=to_timestamp,json=toTimestamp,proto3" json:"to_timestamp,omitempty"`
+	// Types that are assignable to Area:
+	//	*AreaDataRequest_BoundingBox
+	//	*AreaDataRequest_Polygon
+	//	*AreaDataRequest_Circle
+	Area isAreaDataRequest_Area `protobuf_oneof:"area"`
+}
+
+func (x *AreaDataRequest) Reset() {
+	*x = AreaDataRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_telematics_data_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AreaDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AreaDataRequest) ProtoMessage() {}
+
+func (x *AreaDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_telematics_data_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AreaDataRequest.ProtoReflect.Descriptor instead.
+func (*AreaDataRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_telematics_data_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *AreaDataRequest) GetFromTimestamp() int64 {
+	if x != nil {
+		return x.FromTimestamp
+	}
+	return 0
+}
+
+func (x *AreaDataRequest) GetToTimestamp() int64 {
+	if x != nil {
+		return x.ToTimestamp
+	}
+	return 0
+}
+
+func (m *AreaDataRequest) GetArea() isAreaDataRequest_Area {
+	if m != nil {
+		return m.Area
+	}
+	return nil
+}
+
+func (x *AreaDataRequest) GetBoundingBox() *BoundingBox {
+	if x, ok := x.GetArea().(*AreaDataRequest_BoundingBox); ok {
+		return x.BoundingBox
+	}
+	return nil
+}
+
+func (x *AreaDataRequest) GetPolygon() *Polygon {
+	if x, ok := x.GetArea().(*AreaDataRequest_Polygon); ok {
+		return x.Polygon
+	}
+	return nil
+}
+
+func (x *AreaDataRequest) GetCircle() *Circle {
+	if x, ok := x.GetArea().(*AreaDataRequest_Circle); ok {
+		return x.Circle
+	}
+	return nil
+}
+
+type isAreaDataRequest_Area interface {
+	isAreaDataRequest_Area()
+}
+
+type AreaDataRequest_BoundingBox struct {
+	BoundingBox *BoundingBox `protobuf:"bytes,3,opt,name=bounding_box,json=boundingBox,proto3,oneof"`
+}
+
+type AreaDataRequest_Polygon struct {
+	Polygon *Polygon `protobuf:"bytes,4,opt,name=polygon,proto3,oneof"`
+}
+
+type AreaDataRequest_Circle struct {
+	Circle *Circle `protobuf:"bytes,5,opt,name=circle,proto3,oneof"`
+}
+
+func (*AreaDataRequest_BoundingBox) isAreaDataRequest_Area() {}
+
+func (*AreaDataRequest_Polygon) isAreaDataRequest_Area() {}
+
+func (*AreaDataRequest_Circle) isAreaDataRequest_Area() {}
+
+// Records matching all given filters are streamed. If from_timestamp is set,
+// the cached records since then are replayed before the live ones.
+type SubscribeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VehicleIds    []int32      `protobuf:"varint,1,rep,packed,name=vehicle_ids,json=vehicleIds,proto3" json:"vehicle_ids,omitempty"`
+	FleetProfiles []string     `protobuf:"bytes,2,rep,name=fleet_profiles,json=fleetProfiles,proto3" json:"fleet_profiles,omitempty"`
+	BoundingBox   *BoundingBox `protobuf:"bytes,3,opt,name=bounding_box,json=boundingBox,proto3" json:"bounding_box,omitempty"`
+	MinSpeed      int32        `protobuf:"varint,4,opt,name=min_speed,json=minSpeed,proto3" json:"min_speed,omitempty"`
+	FromTimestamp int64        `protobuf:"varint,5,opt,name=from_timestamp,json=fromTimestamp,proto3" json:"from_timestamp,omitempty"`
+}
+
+func (x *SubscribeRequest) Reset() {
+	*x = SubscribeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_telematics_data_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeRequest) ProtoMessage() {}
+
+func (x *SubscribeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_telematics_data_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_telematics_data_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SubscribeRequest) GetVehicleIds() []int32 {
+	if x != nil {
+		return x.VehicleIds
+	}
+	return nil
+}
+
+func (x *SubscribeRequest) GetFleetProfiles() []string {
+	if x != nil {
+		return x.FleetProfiles
+	}
+	return nil
+}
+
+func (x *SubscribeRequest) GetBoundingBox() *BoundingBox {
+	if x != nil {
+		return x.BoundingBox
+	}
+	return nil
+}
+
+func (x *SubscribeRequest) GetMinSpeed() int32 {
+	if x != nil {
+		return x.MinSpeed
+	}
+	return 0
+}
+
+func (x *SubscribeRequest) GetFromTimestamp() int64 {
+	if x != nil {
+		return x.FromTimestamp
+	}
+	return 0
+}
+
+// The latest record of every vehicle matching all given filters.
+type FleetSnapshotRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VehicleIds    []int32      `protobuf:"varint,1,rep,packed,name=vehicle_ids,json=vehicleIds,proto3" json:"vehicle_ids,omitempty"`
+	FleetProfiles []string     `protobuf:"bytes,2,rep,name=fleet_profiles,json=fleetProfiles,proto3" json:"fleet_profiles,omitempty"`
+	BoundingBox   *BoundingBox `protobuf:"bytes,3,opt,name=bounding_box,json=boundingBox,proto3" json:"bounding_box,omitempty"`
+	MinSpeed      int32        `protobuf:"varint,4,opt,name=min_speed,json=minSpeed,proto3" json:"min_speed,omitempty"`
+}
+
+func (x *FleetSnapshotRequest) Reset() {
+	*x = FleetSnapshotRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_telematics_data_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FleetSnapshotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FleetSnapshotRequest) ProtoMessage() {}
+
+func (x *FleetSnapshotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_telematics_data_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FleetSnapshotRequest.ProtoReflect.Descriptor instead.
+func (*FleetSnapshotRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_telematics_data_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *FleetSnapshotRequest) GetVehicleIds() []int32 {
+	if x != nil {
+		return x.VehicleIds
+	}
+	return nil
+}
+
+func (x *FleetSnapshotRequest) GetFleetProfiles() []string {
+	if x != nil {
+		return x.FleetProfiles
+	}
+	return nil
+}
+
+func (x *FleetSnapshotRequest) GetBoundingBox() *BoundingBox {
+	if x != nil {
+		return x.BoundingBox
+	}
+	return nil
+}
+
+func (x *FleetSnapshotRequest) GetMinSpeed() int32 {
+	if x != nil {
+		return x.MinSpeed
+	}
+	return 0
+}
+
+type FleetSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Vehicles []*TelematicsDataProto `protobuf:"bytes,1,rep,name=vehicles,proto3" json:"vehicles,omitempty"`
+}
+
+func (x *FleetSnapshot) Reset() {
+	*x = FleetSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_telematics_data_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FleetSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FleetSnapshot) ProtoMessage() {}
+
+func (x *FleetSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_telematics_data_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FleetSnapshot.ProtoReflect.Descriptor instead.
+func (*FleetSnapshot) Descriptor() ([]byte, []int) {
+	return file_protobuf_telematics_data_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *FleetSnapshot) GetVehicles() []*TelematicsDataProto {
+	if x != nil {
+		return x.Vehicles
+	}
+	return nil
+}
+
+type VehicleLatestRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VehicleId int32 `protobuf:"varint,1,opt,name=vehicle_id,json=vehicleId,proto3" json:"vehicle_id,omitempty"`
+}
+
+func (x *VehicleLatestRequest) Reset() {
+	*x = VehicleLatestRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_telematics_data_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VehicleLatestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VehicleLatestRequest) ProtoMessage() {}
+
+func (x *VehicleLatestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_telematics_data_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VehicleLatestRequest.ProtoReflect.Descriptor instead.
+func (*VehicleLatestRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_telematics_data_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *VehicleLatestRequest) GetVehicleId() int32 {
+	if x != nil {
+		return x.VehicleId
+	}
+	return 0
+}
+
+// Positions of the vehicles at the timestamp. Samples more than max_gap apart,
+// 10 minutes by default, are not considered reliable for interpolation.
+type FleetAtTimeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Timestamp     int64                `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	MaxGap        *durationpb.Duration `protobuf:"bytes,2,opt,name=max_gap,json=maxGap,proto3" json:"max_gap,omitempty"`
+	VehicleIds    []int32              `protobuf:"varint,3,rep,packed,name=vehicle_ids,json=vehicleIds,proto3" json:"vehicle_ids,omitempty"`
+	FleetProfiles []string             `protobuf:"bytes,4,rep,name=fleet_profiles,json=fleetProfiles,proto3" json:"fleet_profiles,omitempty"`
+}
+
+func (x *FleetAtTimeRequest) Reset() {
+	*x = FleetAtTimeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_telematics_data_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FleetAtTimeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FleetAtTimeRequest) ProtoMessage() {}
+
+func (x *FleetAtTimeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_telematics_data_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FleetAtTimeRequest.ProtoReflect.Descriptor instead.
+func (*FleetAtTimeRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_telematics_data_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *FleetAtTimeRequest) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *FleetAtTimeRequest) GetMaxGap() *durationpb.Duration {
+	if x != nil {
+		return x.MaxGap
+	}
+	return nil
+}
+
+func (x *FleetAtTimeRequest) GetVehicleIds() []int32 {
+	if x != nil {
+		return x.VehicleIds
+	}
+	return nil
+}
+
+func (x *FleetAtTimeRequest) GetFleetProfiles() []string {
+	if x != nil {
+		return x.FleetProfiles
+	}
+	return nil
+}
+
+// gap is the time between the samples the position is based on, or since the
+// last sample if the vehicle has no samples after the timestamp.
+type VehiclePosition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Position     *TelematicsDataProto `protobuf:"bytes,1,opt,name=position,proto3" json:"position,omitempty"`
+	Interpolated bool                 `protobuf:"varint,2,opt,name=interpolated,proto3" json:"interpolated,omitempty"`
+	Reliable     bool                 `protobuf:"varint,3,opt,name=reliable,proto3" json:"reliable,omitempty"`
+	Gap          *durationpb.Duration `protobuf:"bytes,4,opt,name=gap,proto3" json:"gap,omitempty"`
+}
+
+func (x *VehiclePosition) Reset() {
+	*x = VehiclePosition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_telematics_data_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VehiclePosition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VehiclePosition) ProtoMessage() {}
+
+func (x *VehiclePosition) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_telematics_data_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VehiclePosition.ProtoReflect.Descriptor instead.
+func (*VehiclePosition) Descriptor() ([]byte, []int) {
+	return file_protobuf_telematics_data_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *VehiclePosition) GetPosition() *TelematicsDataProto {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+func (x *VehiclePosition) GetInterpolated() bool {
+	if x != nil {
+		return x.Interpolated
+	}
+	return false
+}
+
+func (x *VehiclePosition) GetReliable() bool {
+	if x != nil {
+		return x.Reliable
+	}
+	return false
+}
+
+func (x *VehiclePosition) GetGap() *durationpb.Duration {
+	if x != nil {
+		return x.Gap
+	}
+	return nil
+}
+
+type FleetAtTime struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Vehicles []*VehiclePosition `protobuf:"bytes,1,rep,name=vehicles,proto3" json:"vehicles,omitempty"`
+}
+
+func (x *FleetAtTime) Reset() {
+	*x = FleetAtTime{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_telematics_data_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FleetAtTime) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FleetAtTime) ProtoMessage() {}
+
+func (x *FleetAtTime) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_telematics_data_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FleetAtTime.ProtoReflect.Descriptor instead.
+func (*FleetAtTime) Descriptor() ([]byte, []int) {
+	return file_protobuf_telematics_data_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *FleetAtTime) GetVehicles() []*VehiclePosition {
+	if x != nil {
+		return x.Vehicles
+	}
+	return nil
+}
+
+// Stats of the given vehicles, or of all of them, over the records between
+// the timestamps. Speeds up to idle_speed count as standing still.
+type VehicleStatsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromTimestamp int64   `protobuf:"varint,1,opt,name=from_timestamp,json=fromTimestamp,proto3" json:"from_timestamp,omitempty"`
+	ToTimestamp   int64   `protobuf:"varint,2,opt,name=to_timestamp,json=toTimestamp,proto3" json:"to_timestamp,omitempty"`
+	VehicleIds    []int32 `protobuf:"varint,3,rep,packed,name=vehicle_ids,json=vehicleIds,proto3" json:"vehicle_ids,omitempty"`
+	IdleSpeed     int32   `protobuf:"varint,4,opt,name=idle_speed,json=idleSpeed,proto3" json:"idle_speed,omitempty"`
+}
+
+func (x *VehicleStatsRequest) Reset() {
+	*x = VehicleStatsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_telematics_data_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VehicleStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VehicleStatsRequest) ProtoMessage() {}
+
+func (x *VehicleStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_telematics_data_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VehicleStatsRequest.ProtoReflect.Descriptor instead.
+func (*VehicleStatsRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_telematics_data_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *VehicleStatsRequest) GetFromTimestamp() int64 {
+	if x != nil {
+		return x.FromTimestamp
+	}
+	return 0
+}
+
+func (x *VehicleStatsRequest) GetToTimestamp() int64 {
+	if x != nil {
+		return x.ToTimestamp
+	}
+	return 0
+}
+
+func (x *VehicleStatsRequest) GetVehicleIds() []int32 {
+	if x != nil {
+		return x.VehicleIds
+	}
+	return nil
+}
+
+func (x *VehicleStatsRequest) GetIdleSpeed() int32 {
+	if x != nil {
+		return x.IdleSpeed
+	}
+	return 0
+}
+
+type VehicleStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VehicleId      int32                `protobuf:"varint,1,opt,name=vehicle_id,json=vehicleId,proto3" json:"vehicle_id,omitempty"`
+	Count          int32                `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	DistanceMeters float64              `protobuf:"fixed64,3,opt,name=distance_meters,json=distanceMeters,proto3" json:"distance_meters,omitempty"`
+	MovingTime     *durationpb.Duration `protobuf:"bytes,4,opt,name=moving_time,json=movingTime,proto3" json:"moving_time,omitempty"`
+	IdleTime       *durationpb.Duration `protobuf:"bytes,5,opt,name=idle_time,json=idleTime,proto3" json:"idle_time,omitempty"`
+	MaxSpeed       int32                `protobuf:"varint,6,opt,name=max_speed,json=maxSpeed,proto3" json:"max_speed,omitempty"`
+	AvgSpeed       float64              `protobuf:"fixed64,7,opt,name=avg_speed,json=avgSpeed,proto3" json:"avg_speed,omitempty"`
+	Stops          int32                `protobuf:"varint,8,opt,name=stops,proto3" json:"stops,omitempty"`
+}
+
+func (x *VehicleStats) Reset() {
+	*x = VehicleStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_telematics_data_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VehicleStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VehicleStats) ProtoMessage() {}
+
+func (x *VehicleStats) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_telematics_data_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VehicleStats.ProtoReflect.Descriptor instead.
+func (*VehicleStats) Descriptor() ([]byte, []int) {
+	return file_protobuf_telematics_data_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *VehicleStats) GetVehicleId() int32 {
+	if x != nil {
+		return x.VehicleId
+	}
+	return 0
+}
+
+func (x *VehicleStats) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *VehicleStats) GetDistanceMeters() float64 {
+	if x != nil {
+		return x.DistanceMeters
+	}
+	return 0
+}
+
+func (x *VehicleStats) GetMovingTime() *durationpb.Duration {
+	if x != nil {
+		return x.MovingTime
+	}
+	return nil
+}
+
+func (x *VehicleStats) GetIdleTime() *durationpb.Duration {
+	if x != nil {
+		return x.IdleTime
+	}
+	return nil
+}
+
+func (x *VehicleStats) GetMaxSpeed() int32 {
+	if x != nil {
+		return x.MaxSpeed
+	}
+	return 0
+}
+
+func (x *VehicleStats) GetAvgSpeed() float64 {
+	if x != nil {
+		return x.AvgSpeed
+	}
+	return 0
+}
+
+func (x *VehicleStats) GetStops() int32 {
+	if x != nil {
+		return x.Stops
+	}
+	return 0
+}
+
+// fleet sums the stats of all vehicles, its avg_speed is weighted by count.
+type VehicleStatsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Vehicles []*VehicleStats `protobuf:"bytes,1,rep,name=vehicles,proto3" json:"vehicles,omitempty"`
+	Fleet    *VehicleStats   `protobuf:"bytes,2,opt,name=fleet,proto3" json:"fleet,omitempty"`
+}
+
+func (x *VehicleStatsResponse) Reset() {
+	*x = VehicleStatsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_telematics_data_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VehicleStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VehicleStatsResponse) ProtoMessage() {}
+
+func (x *VehicleStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_telematics_data_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VehicleStatsResponse.ProtoReflect.Descriptor instead.
+func (*VehicleStatsResponse) Descriptor() ([]byte, []int) {
+	return file_protobuf_telematics_data_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *VehicleStatsResponse) GetVehicles() []*VehicleStats {
+	if x != nil {
+		return x.Vehicles
+	}
+	return nil
+}
+
+func (x *VehicleStatsResponse) GetFleet() *VehicleStats {
+	if x != nil {
+		return x.Fleet
+	}
+	return nil
+}
+
+// A trip starts when the vehicle moves faster than idle_speed and ends once it
+// has stood still for min_stop. Both default to the configured trip settings.
+type TripOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	IdleSpeed *int32               `protobuf:"varint,1,opt,name=idle_speed,json=idleSpeed,proto3,oneof" json:"idle_speed,omitempty"`
+	MinStop   *durationpb.Duration `protobuf:"bytes,2,opt,name=min_stop,json=minStop,proto3" json:"min_stop,omitempty"`
+}
+
+func (x *TripOptions) Reset() {
+	*x = TripOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_telematics_data_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TripOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TripOptions) ProtoMessage() {}
+
+func (x *TripOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_telematics_data_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TripOptions.ProtoReflect.Descriptor instead.
+func (*TripOptions) Descriptor() ([]byte, []int) {
+	return file_protobuf_telematics_data_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *TripOptions) GetIdleSpeed() int32 {
+	if x != nil && x.IdleSpeed != nil {
+		return *x.IdleSpeed
+	}
+	return 0
+}
+
+func (x *TripOptions) GetMinStop() *durationpb.Duration {
+	if x != nil {
+		return x.MinStop
+	}
+	return nil
+}
+
+// Trips of the given vehicles, or of all of them, split from the records
+// between the timestamps.
+type ListTripsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromTimestamp int64        `protobuf:"varint,1,opt,name=from_timestamp,json=fromTimestamp,proto3" json:"from_timestamp,omitempty"`
+	ToTimestamp   int64        `protobuf:"varint,2,opt,name=to_timestamp,json=toTimestamp,proto3" json:"to_timestamp,omitempty"`
+	VehicleIds    []int32      `protobuf:"varint,3,rep,packed,name=vehicle_ids,json=vehicleIds,proto3" json:"vehicle_ids,omitempty"`
+	Options       *TripOptions `protobuf:"bytes,4,opt,name=options,proto3" json:"options,omitempty"`
+}
+
+func (x *ListTripsRequest) Reset() {
+	*x = ListTripsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_telematics_data_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTripsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTripsRequest) ProtoMessage() {}
+
+func (x *ListTripsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_telematics_data_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTripsRequest.ProtoReflect.Descriptor instead.
+func (*ListTripsRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_telematics_data_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListTripsRequest) GetFromTimestamp() int64 {
+	if x != nil {
+		return x.FromTimestamp
+	}
+	return 0
+}
+
+func (x *ListTripsRequest) GetToTimestamp() int64 {
+	if x != nil {
+		return x.ToTimestamp
+	}
+	return 0
+}
+
+func (x *ListTripsRequest) GetVehicleIds() []int32 {
+	if x != nil {
+		return x.VehicleIds
+	}
+	return nil
+}
+
+func (x *ListTripsRequest) GetOptions() *TripOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+type ListTripsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Trips []*Trip `protobuf:"bytes,1,rep,name=trips,proto3" json:"trips,omitempty"`
+}
+
+func (x *ListTripsResponse) Reset() {
+	*x = ListTripsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_telematics_data_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListTripsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTripsResponse) ProtoMessage() {}
+
+func (x *ListTripsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_telematics_data_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTripsResponse.ProtoReflect.Descriptor instead.
+func (*ListTripsResponse) Descriptor() ([]byte, []int) {
+	return file_protobuf_telematics_data_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListTripsResponse) GetTrips() []*Trip {
+	if x != nil {
+		return x.Trips
+	}
+	return nil
+}
+
+// The trip with the ID from ListTrips or a trip event, with its points.
+type GetTripRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TripId  string       `protobuf:"bytes,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	Options *TripOptions `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
+}
+
+func (x *GetTripRequest) Reset() {
+	*x = GetTripRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_telematics_data_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTripRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTripRequest) ProtoMessage() {}
+
+func (x *GetTripRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_telematics_data_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTripRequest.ProtoReflect.Descriptor instead.
+func (*GetTripRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_telematics_data_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetTripRequest) GetTripId() string {
+	if x != nil {
+		return x.TripId
+	}
+	return ""
+}
+
+func (x *GetTripRequest) GetOptions() *TripOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+// end is the first record of the stop ending the trip, or the latest record
+// of an ongoing trip. points are filled by GetTrip only.
+type Trip struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TripId         string                 `protobuf:"bytes,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	VehicleId      int32                  `protobuf:"varint,2,opt,name=vehicle_id,json=vehicleId,proto3" json:"vehicle_id,omitempty"`
+	Start          *TelematicsDataProto   `protobuf:"bytes,3,opt,name=start,proto3" json:"start,omitempty"`
+	End            *TelematicsDataProto   `protobuf:"bytes,4,opt,name=end,proto3" json:"end,omitempty"`
+	DistanceMeters float64                `protobuf:"fixed64,5,opt,name=distance_meters,json=distanceMeters,proto3" json:"distance_meters,omitempty"`
+	Duration       *durationpb.Duration   `protobuf:"bytes,6,opt,name=duration,proto3" json:"duration,omitempty"`
+	MaxSpeed       int32                  `protobuf:"varint,7,opt,name=max_speed,json=maxSpeed,proto3" json:"max_speed,omitempty"`
+	Ongoing        bool                   `protobuf:"varint,8,opt,name=ongoing,proto3" json:"ongoing,omitempty"`
+	Points         []*TelematicsDataProto `protobuf:"bytes,9,rep,name=points,proto3" json:"points,omitempty"`
+}
+
+func (x *Trip) Reset() {
+	*x = Trip{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_telematics_data_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Trip) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Trip) ProtoMessage() {}
+
+func (x *Trip) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_telematics_data_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Trip.ProtoReflect.Descriptor instead.
+func (*Trip) Descriptor() ([]byte, []int) {
+	return file_protobuf_telematics_data_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *Trip) GetTripId() string {
+	if x != nil {
+		return x.TripId
+	}
+	return ""
+}
+
+func (x *Trip) GetVehicleId() int32 {
+	if x != nil {
+		return x.VehicleId
+	}
+	return 0
+}
+
+func (x *Trip) GetStart() *TelematicsDataProto {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *Trip) GetEnd() *TelematicsDataProto {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *Trip) GetDistanceMeters() float64 {
+	if x != nil {
+		return x.DistanceMeters
+	}
+	return 0
+}
+
+func (x *Trip) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *Trip) GetMaxSpeed() int32 {
+	if x != nil {
+		return x.MaxSpeed
+	}
+	return 0
+}
+
+func (x *Trip) GetOngoing() bool {
+	if x != nil {
+		return x.Ongoing
+	}
+	return false
+}
+
+func (x *Trip) GetPoints() []*TelematicsDataProto {
+	if x != nil {
+		return x.Points
+	}
+	return nil
+}
+
+// Published to the trips topic when a trip starts or ends.
+type TripEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type TripEventType `protobuf:"varint,1,opt,name=type,proto3,enum=proto.TripEventType" json:"type,omitempty"`
+	Trip *Trip         `protobuf:"bytes,2,opt,name=trip,proto3" json:"trip,omitempty"`
+}
+
+func (x *TripEvent) Reset() {
+	*x = TripEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_telematics_data_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TripEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TripEvent) ProtoMessage() {}
+
+func (x *TripEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_telematics_data_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TripEvent.ProtoReflect.Descriptor instead.
+func (*TripEvent) Descriptor() ([]byte, []int) {
+	return file_protobuf_telematics_data_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *TripEvent) GetType() TripEventType {
+	if x != nil {
+		return x.Type
+	}
+	return TripEventType_TRIP_EVENT_STARTED
+}
+
+func (x *TripEvent) GetTrip() *Trip {
+	if x != nil {
+		return x.Trip
+	}
+	return nil
+}
+
+// Stops of the given vehicles, or of all of them, over the records between
+// the timestamps: records at most stop_speed fast and within radius_meters of
+// the first of them for at least min_duration. Stops within
+// place_radius_meters of each other are visits of the same place, places with
+// at least min_visits visits are returned. Unset fields default to the trip
+// idle speed, 50 m, 5 minutes, 200 m and 2 visits.
+type StopsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromTimestamp     int64                `protobuf:"varint,1,opt,name=from_timestamp,json=fromTimestamp,proto3" json:"from_timestamp,omitempty"`
+	ToTimestamp       int64                `protobuf:"varint,2,opt,name=to_timestamp,json=toTimestamp,proto3" json:"to_timestamp,omitempty"`
+	VehicleIds        []int32              `protobuf:"varint,3,rep,packed,name=vehicle_ids,json=vehicleIds,proto3" json:"vehicle_ids,omitempty"`
+	StopSpeed         *int32               `protobuf:"varint,4,opt,name=stop_speed,json=stopSpeed,proto3,oneof" json:"stop_speed,omitempty"`
+	RadiusMeters      float64              `protobuf:"fixed64,5,opt,name=radius_meters,json=radiusMeters,proto3" json:"radius_meters,omitempty"`
+	MinDuration       *durationpb.Duration `protobuf:"bytes,6,opt,name=min_duration,json=minDuration,proto3" json:"min_duration,omitempty"`
+	PlaceRadiusMeters float64              `protobuf:"fixed64,7,opt,name=place_radius_meters,json=placeRadiusMeters,proto3" json:"place_radius_meters,omitempty"`
+	MinVisits         int32                `protobuf:"varint,8,opt,name=min_visits,json=minVisits,proto3" json:"min_visits,omitempty"`
+}
+
+func (x *StopsRequest) Reset() {
+	*x = StopsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_telematics_data_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StopsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopsRequest) ProtoMessage() {}
+
+func (x *StopsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_telematics_data_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopsRequest.ProtoReflect.Descriptor instead.
+func (*StopsRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_telematics_data_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *StopsRequest) GetFromTimestamp() int64 {
+	if x != nil {
+		return x.FromTimestamp
+	}
+	return 0
+}
+
+func (x *StopsRequest) GetToTimestamp() int64 {
+	if x != nil {
+		return x.ToTimestamp
+	}
+	return 0
+}
+
+func (x *StopsRequest) GetVehicleIds() []int32 {
+	if x != nil {
+		return x.VehicleIds
+	}
+	return nil
+}
+
+func (x *StopsRequest) GetStopSpeed() int32 {
+	if x != nil && x.StopSpeed != nil {
+		return *x.StopSpeed
+	}
+	return 0
+}
+
+func (x *StopsRequest) GetRadiusMeters() float64 {
+	if x != nil {
+		return x.RadiusMeters
+	}
+	return 0
+}
+
+func (x *StopsRequest) GetMinDuration() *durationpb.Duration {
+	if x != nil {
+		return x.MinDuration
+	}
+	return nil
+}
+
+func (x *StopsRequest) GetPlaceRadiusMeters() float64 {
+	if x != nil {
+		return x.PlaceRadiusMeters
+	}
+	return 0
+}
+
+func (x *StopsRequest) GetMinVisits() int32 {
+	if x != nil {
+		return x.MinVisits
+	}
+	return 0
+}
+
+// place_id refers to a place of the vehicle, 0 if the place is visited less
+// than min_visits times.
+type Stop struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Location  *GeoPoint            `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	Arrival   int64                `protobuf:"varint,2,opt,name=arrival,proto3" json:"arrival,omitempty"`
+	Departure int64                `protobuf:"varint,3,opt,name=departure,proto3" json:"departure,omitempty"`
+	Duration  *durationpb.Duration `protobuf:"bytes,4,opt,name=duration,proto3" json:"duration,omitempty"`
+	Count     int32                `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	PlaceId   int32                `protobuf:"varint,6,opt,name=place_id,json=placeId,proto3" json:"place_id,omitempty"`
+}
+
+func (x *Stop) Reset() {
+	*x = Stop{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_telematics_data_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Stop) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Stop) ProtoMessage() {}
+
+func (x *Stop) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_telematics_data_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Stop.ProtoReflect.Descriptor instead.
+func (*Stop) Descriptor() ([]byte, []int) {
+	return file_protobuf_telematics_data_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Stop) GetLocation() *GeoPoint {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *Stop) GetArrival() int64 {
+	if x != nil {
+		return x.Arrival
+	}
+	return 0
+}
+
+func (x *Stop) GetDeparture() int64 {
+	if x != nil {
+		return x.Departure
+	}
+	return 0
+}
+
+func (x *Stop) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+func (x *Stop) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *Stop) GetPlaceId() int32 {
+	if x != nil {
+		return x.PlaceId
+	}
+	return 0
+}
+
+type Place struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PlaceId  int32                `protobuf:"varint,1,opt,name=place_id,json=placeId,proto3" json:"place_id,omitempty"`
+	Location *GeoPoint            `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+	Visits   int32                `protobuf:"varint,3,opt,name=visits,proto3" json:"visits,omitempty"`
+	Dwell    *durationpb.Duration `protobuf:"bytes,4,opt,name=dwell,proto3" json:"dwell,omitempty"`
+}
+
+func (x *Place) Reset() {
+	*x = Place{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_telematics_data_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Place) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Place) ProtoMessage() {}
+
+func (x *Place) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_telematics_data_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Place.ProtoReflect.Descriptor instead.
+func (*Place) Descriptor() ([]byte, []int) {
+	return file_protobuf_telematics_data_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *Place) GetPlaceId() int32 {
+	if x != nil {
+		return x.PlaceId
+	}
+	return 0
+}
+
+func (x *Place) GetLocation() *GeoPoint {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *Place) GetVisits() int32 {
+	if x != nil {
+		return x.Visits
+	}
+	return 0
+}
+
+func (x *Place) GetDwell() *durationpb.Duration {
+	if x != nil {
+		return x.Dwell
+	}
+	return nil
+}
+
+// Places are ordered by visits and dwell time, most visited first.
+type VehicleStops struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VehicleId int32    `protobuf:"varint,1,opt,name=vehicle_id,json=vehicleId,proto3" json:"vehicle_id,omitempty"`
+	Stops     []*Stop  `protobuf:"bytes,2,rep,name=stops,proto3" json:"stops,omitempty"`
+	Places    []*Place `protobuf:"bytes,3,rep,name=places,proto3" json:"places,omitempty"`
+}
+
+func (x *VehicleStops) Reset() {
+	*x = VehicleStops{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_telematics_data_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VehicleStops) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VehicleStops) ProtoMessage() {}
+
+func (x *VehicleStops) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_telematics_data_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VehicleStops.ProtoReflect.Descriptor instead.
+func (*VehicleStops) Descriptor() ([]byte, []int) {
+	return file_protobuf_telematics_data_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *VehicleStops) GetVehicleId() int32 {
+	if x != nil {
+		return x.VehicleId
+	}
+	return 0
+}
+
+func (x *VehicleStops) GetStops() []*Stop {
+	if x != nil {
+		return x.Stops
+	}
+	return nil
+}
+
+func (x *VehicleStops) GetPlaces() []*Place {
+	if x != nil {
+		return x.Places
+	}
+	return nil
+}
+
+type StopsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Vehicles []*VehicleStops `protobuf:"bytes,1,rep,name=vehicles,proto3" json:"vehicles,omitempty"`
+}
+
+func (x *StopsResponse) Reset() {
+	*x = StopsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_telematics_data_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StopsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopsResponse) ProtoMessage() {}
+
+func (x *StopsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_telematics_data_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopsResponse.ProtoReflect.Descriptor instead.
+func (*StopsResponse) Descriptor() ([]byte, []int) {
+	return file_protobuf_telematics_data_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *StopsResponse) GetVehicles() []*VehicleStops {
+	if x != nil {
+		return x.Vehicles
+	}
+	return nil
+}
+
+// Density of the records between the timestamps, optionally of the vehicles
+// of the given fleet profiles within the bounding box. Geohash cells have
+// geohash_precision characters, 6 by default; squares and hexagons are
+// cell_size_meters wide, 1000 by default, and cover equal areas.
+type HeatmapRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromTimestamp    int64        `protobuf:"varint,1,opt,name=from_timestamp,json=fromTimestamp,proto3" json:"from_timestamp,omitempty"`
+	ToTimestamp      int64        `protobuf:"varint,2,opt,name=to_timestamp,json=toTimestamp,proto3" json:"to_timestamp,omitempty"`
+	Grid             GridType     `protobuf:"varint,3,opt,name=grid,proto3,enum=proto.GridType" json:"grid,omitempty"`
+	GeohashPrecision int32        `protobuf:"varint,4,opt,name=geohash_precision,json=geohashPrecision,proto3" json:"geohash_precision,omitempty"`
+	CellSizeMeters   float64      `protobuf:"fixed64,5,opt,name=cell_size_meters,json=cellSizeMeters,proto3" json:"cell_size_meters,omitempty"`
+	FleetProfiles    []string     `protobuf:"bytes,6,rep,name=fleet_profiles,json=fleetProfiles,proto3" json:"fleet_profiles,omitempty"`
+	BoundingBox      *BoundingBox `protobuf:"bytes,7,opt,name=bounding_box,json=boundingBox,proto3" json:"bounding_box,omitempty"`
+}
+
+func (x *HeatmapRequest) Reset() {
+	*x = HeatmapRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_telematics_data_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeatmapRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeatmapRequest) ProtoMessage() {}
+
+func (x *HeatmapRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_telematics_data_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeatmapRequest.ProtoReflect.Descriptor instead.
+func (*HeatmapRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_telematics_data_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *HeatmapRequest) GetFromTimestamp() int64 {
+	if x != nil {
+		return x.FromTimestamp
+	}
+	return 0
+}
+
+func (x *HeatmapRequest) GetToTimestamp() int64 {
+	if x != nil {
+		return x.ToTimestamp
+	}
+	return 0
+}
+
+func (x *HeatmapRequest) GetGrid() GridType {
+	if x != nil {
+		return x.Grid
+	}
+	return GridType_GRID_GEOHASH
+}
+
+func (x *HeatmapRequest) GetGeohashPrecision() int32 {
+	if x != nil {
+		return x.GeohashPrecision
+	}
+	return 0
+}
+
+func (x *HeatmapRequest) GetCellSizeMeters() float64 {
+	if x != nil {
+		return x.CellSizeMeters
+	}
+	return 0
+}
+
+func (x *HeatmapRequest) GetFleetProfiles() []string {
+	if x != nil {
+		return x.FleetProfiles
+	}
+	return nil
+}
+
+func (x *HeatmapRequest) GetBoundingBox() *BoundingBox {
+	if x != nil {
+		return x.BoundingBox
+	}
+	return nil
+}
+
+type HeatmapCell struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CellId   string    `protobuf:"bytes,1,opt,name=cell_id,json=cellId,proto3" json:"cell_id,omitempty"`
+	Center   *GeoPoint `protobuf:"bytes,2,opt,name=center,proto3" json:"center,omitempty"`
+	Count    int32     `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	AvgSpeed float64   `protobuf:"fixed64,4,opt,name=avg_speed,json=avgSpeed,proto3" json:"avg_speed,omitempty"`
+	MaxSpeed int32     `protobuf:"varint,5,opt,name=max_speed,json=maxSpeed,proto3" json:"max_speed,omitempty"`
+}
+
+func (x *HeatmapCell) Reset() {
+	*x = HeatmapCell{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_telematics_data_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HeatmapCell) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HeatmapCell) ProtoMessage() {}
+
+func (x *HeatmapCell) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_telematics_data_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HeatmapCell.ProtoReflect.Descriptor instead.
+func (*HeatmapCell) Descriptor() ([]byte, []int) {
+	return file_protobuf_telematics_data_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *HeatmapCell) GetCellId() string {
+	if x != nil {
+		return x.CellId
+	}
+	return ""
+}
+
+func (x *HeatmapCell) GetCenter() *GeoPoint {
+	if x != nil {
+		return x.Center
+	}
+	return nil
+}
+
+func (x *HeatmapCell) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *HeatmapCell) GetAvgSpeed() float64 {
+	if x != nil {
+		return x.AvgSpeed
+	}
+	return 0
+}
+
+func (x *HeatmapCell) GetMaxSpeed() int32 {
+	if x != nil {
+		return x.MaxSpeed
+	}
+	return 0
+}
+
+// Non-empty cells, the densest first.
+type Heatmap struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cells []*HeatmapCell `protobuf:"bytes,1,rep,name=cells,proto3" json:"cells,omitempty"`
+	Total int32          `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (x *Heatmap) Reset() {
+	*x = Heatmap{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_telematics_data_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Heatmap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Heatmap) ProtoMessage() {}
+
+func (x *Heatmap) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_telematics_data_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Heatmap.ProtoReflect.Descriptor instead.
+func (*Heatmap) Descriptor() ([]byte, []int) {
+	return file_protobuf_telematics_data_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *Heatmap) GetCells() []*HeatmapCell {
+	if x != nil {
+		return x.Cells
+	}
+	return nil
+}
+
+func (x *Heatmap) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+// A vehicle_id of 0 adds the vehicle with the lowest ID above the running
+// ones. Zero max_speed and max_time_step keep the current settings of the
+// vehicle, by default the ones of its fleet profile or the generator.
+type AddVehicleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VehicleId   int32                `protobuf:"varint,1,opt,name=vehicle_id,json=vehicleId,proto3" json:"vehicle_id,omitempty"`
+	MaxSpeed    int32                `protobuf:"varint,2,opt,name=max_speed,json=maxSpeed,proto3" json:"max_speed,omitempty"`
+	MaxTimeStep *durationpb.Duration `protobuf:"bytes,3,opt,name=max_time_step,json=maxTimeStep,proto3" json:"max_time_step,omitempty"`
+}
+
+func (x *AddVehicleRequest) Reset() {
+	*x = AddVehicleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_telematics_data_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddVehicleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddVehicleRequest) ProtoMessage() {}
+
+func (x *AddVehicleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_telematics_data_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddVehicleRequest.ProtoReflect.Descriptor instead.
+func (*AddVehicleRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_telematics_data_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *AddVehicleRequest) GetVehicleId() int32 {
+	if x != nil {
+		return x.VehicleId
+	}
+	return 0
+}
+
+func (x *AddVehicleRequest) GetMaxSpeed() int32 {
+	if x != nil {
+		return x.MaxSpeed
+	}
+	return 0
+}
+
+func (x *AddVehicleRequest) GetMaxTimeStep() *durationpb.Duration {
+	if x != nil {
+		return x.MaxTimeStep
+	}
+	return nil
+}
+
+type VehicleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VehicleId int32 `protobuf:"varint,1,opt,name=vehicle_id,json=vehicleId,proto3" json:"vehicle_id,omitempty"`
+}
+
+func (x *VehicleRequest) Reset() {
+	*x = VehicleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_telematics_data_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VehicleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VehicleRequest) ProtoMessage() {}
+
+func (x *VehicleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_telematics_data_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VehicleRequest.ProtoReflect.Descriptor instead.
+func (*VehicleRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_telematics_data_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *VehicleRequest) GetVehicleId() int32 {
+	if x != nil {
+		return x.VehicleId
+	}
+	return 0
+}
+
+// Zero fields are left unchanged. max_speed is capped by the generator
+// maxSpeed and max_time_step is whole seconds.
+type TuneVehicleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VehicleId   int32                `protobuf:"varint,1,opt,name=vehicle_id,json=vehicleId,proto3" json:"vehicle_id,omitempty"`
+	MaxSpeed    int32                `protobuf:"varint,2,opt,name=max_speed,json=maxSpeed,proto3" json:"max_speed,omitempty"`
+	MaxTimeStep *durationpb.Duration `protobuf:"bytes,3,opt,name=max_time_step,json=maxTimeStep,proto3" json:"max_time_step,omitempty"`
+}
+
+func (x *TuneVehicleRequest) Reset() {
+	*x = TuneVehicleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_telematics_data_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TuneVehicleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TuneVehicleRequest) ProtoMessage() {}
+
+func (x *TuneVehicleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_telematics_data_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TuneVehicleRequest.ProtoReflect.Descriptor instead.
+func (*TuneVehicleRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_telematics_data_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *TuneVehicleRequest) GetVehicleId() int32 {
+	if x != nil {
+		return x.VehicleId
+	}
+	return 0
+}
+
+func (x *TuneVehicleRequest) GetMaxSpeed() int32 {
+	if x != nil {
+		return x.MaxSpeed
+	}
+	return 0
+}
+
+func (x *TuneVehicleRequest) GetMaxTimeStep() *durationpb.Duration {
+	if x != nil {
+		return x.MaxTimeStep
+	}
+	return nil
+}
+
+// last is the latest generated record of the vehicle, if any.
+type VehicleStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VehicleId    int32                `protobuf:"varint,1,opt,name=vehicle_id,json=vehicleId,proto3" json:"vehicle_id,omitempty"`
+	Paused       bool                 `protobuf:"varint,2,opt,name=paused,proto3" json:"paused,omitempty"`
+	MaxSpeed     int32                `protobuf:"varint,3,opt,name=max_speed,json=maxSpeed,proto3" json:"max_speed,omitempty"`
+	MaxTimeStep  *durationpb.Duration `protobuf:"bytes,4,opt,name=max_time_step,json=maxTimeStep,proto3" json:"max_time_step,omitempty"`
+	FleetProfile string               `protobuf:"bytes,5,opt,name=fleet_profile,json=fleetProfile,proto3" json:"fleet_profile,omitempty"`
+	Last         *TelematicsDataProto `protobuf:"bytes,6,opt,name=last,proto3" json:"last,omitempty"`
+	OdometerKm   float64              `protobuf:"fixed64,7,opt,name=odometer_km,json=odometerKm,proto3" json:"odometer_km,omitempty"`
+	InTrip       bool                 `protobuf:"varint,8,opt,name=in_trip,json=inTrip,proto3" json:"in_trip,omitempty"`
+	Interval     *durationpb.Duration `protobuf:"bytes,9,opt,name=interval,proto3" json:"interval,omitempty"`
+	Immobilised  bool                 `protobuf:"varint,10,opt,name=immobilised,proto3" json:"immobilised,omitempty"`
+	Outputs      uint32               `protobuf:"varint,11,opt,name=outputs,proto3" json:"outputs,omitempty"`
+}
+
+func (x *VehicleStatus) Reset() {
+	*x = VehicleStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_telematics_data_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VehicleStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VehicleStatus) ProtoMessage() {}
+
+func (x *VehicleStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_telematics_data_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VehicleStatus.ProtoReflect.Descriptor instead.
+func (*VehicleStatus) Descriptor() ([]byte, []int) {
+	return file_protobuf_telematics_data_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *VehicleStatus) GetVehicleId() int32 {
+	if x != nil {
+		return x.VehicleId
+	}
+	return 0
+}
+
+func (x *VehicleStatus) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *VehicleStatus) GetMaxSpeed() int32 {
+	if x != nil {
+		return x.MaxSpeed
+	}
+	return 0
+}
+
+func (x *VehicleStatus) GetMaxTimeStep() *durationpb.Duration {
+	if x != nil {
+		return x.MaxTimeStep
+	}
+	return nil
+}
+
+func (x *VehicleStatus) GetFleetProfile() string {
+	if x != nil {
+		return x.FleetProfile
+	}
+	return ""
+}
+
+func (x *VehicleStatus) GetLast() *TelematicsDataProto {
+	if x != nil {
+		return x.Last
+	}
+	return nil
+}
+
+func (x *VehicleStatus) GetOdometerKm() float64 {
+	if x != nil {
+		return x.OdometerKm
+	}
+	return 0
+}
+
+func (x *VehicleStatus) GetInTrip() bool {
+	if x != nil {
+		return x.InTrip
+	}
+	return false
+}
+
+func (x *VehicleStatus) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *VehicleStatus) GetImmobilised() bool {
+	if x != nil {
+		return x.Immobilised
+	}
+	return false
+}
+
+func (x *VehicleStatus) GetOutputs() uint32 {
+	if x != nil {
+		return x.Outputs
+	}
+	return 0
+}
+
+type VehicleList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FleetPaused bool             `protobuf:"varint,1,opt,name=fleet_paused,json=fleetPaused,proto3" json:"fleet_paused,omitempty"`
+	Vehicles    []*VehicleStatus `protobuf:"bytes,2,rep,name=vehicles,proto3" json:"vehicles,omitempty"`
+}
+
+func (x *VehicleList) Reset() {
+	*x = VehicleList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_telematics_data_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VehicleList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VehicleList) ProtoMessage() {}
+
+func (x *VehicleList) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_telematics_data_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VehicleList.ProtoReflect.Descriptor instead.
+func (*VehicleList) Descriptor() ([]byte, []int) {
+	return file_protobuf_telematics_data_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *VehicleList) GetFleetPaused() bool {
+	if x != nil {
+		return x.FleetPaused
+	}
+	return false
+}
+
+func (x *VehicleList) GetVehicles() []*VehicleStatus {
+	if x != nil {
+		return x.Vehicles
+	}
+	return nil
+}
+
+// The simulation clock. now is in nanoseconds, factor tells how many times
+// faster than the wall clock it runs.
+type ClockState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Now    int64                `protobuf:"varint,1,opt,name=now,proto3" json:"now,omitempty"`
+	Factor float64              `protobuf:"fixed64,2,opt,name=factor,proto3" json:"factor,omitempty"`
+	Paused bool                 `protobuf:"varint,3,opt,name=paused,proto3" json:"paused,omitempty"`
+	Tick   *durationpb.Duration `protobuf:"bytes,4,opt,name=tick,proto3" json:"tick,omitempty"`
+}
+
+func (x *ClockState) Reset() {
+	*x = ClockState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_telematics_data_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClockState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClockState) ProtoMessage() {}
+
+func (x *ClockState) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_telematics_data_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClockState.ProtoReflect.Descriptor instead.
+func (*ClockState) Descriptor() ([]byte, []int) {
+	return file_protobuf_telematics_data_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ClockState) GetNow() int64 {
+	if x != nil {
+		return x.Now
+	}
+	return 0
+}
+
+func (x *ClockState) GetFactor() float64 {
+	if x != nil {
+		return x.Factor
+	}
+	return 0
+}
+
+func (x *ClockState) GetPaused() bool {
+	if x != nil {
+		return x.Paused
+	}
+	return false
+}
+
+func (x *ClockState) GetTick() *durationpb.Duration {
+	if x != nil {
+		return x.Tick
+	}
+	return nil
+}
+
+type SetClockSpeedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Factor float64 `protobuf:"fixed64,1,opt,name=factor,proto3" json:"factor,omitempty"`
+}
+
+func (x *SetClockSpeedRequest) Reset() {
+	*x = SetClockSpeedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_telematics_data_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetClockSpeedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetClockSpeedRequest) ProtoMessage() {}
+
+func (x *SetClockSpeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_telematics_data_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetClockSpeedRequest.ProtoReflect.Descriptor instead.
+func (*SetClockSpeedRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_telematics_data_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *SetClockSpeedRequest) GetFactor() float64 {
+	if x != nil {
+		return x.Factor
+	}
+	return 0
+}
+
+type StepClockRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ticks int32 `protobuf:"varint,1,opt,name=ticks,proto3" json:"ticks,omitempty"`
+}
+
+func (x *StepClockRequest) Reset() {
+	*x = StepClockRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_telematics_data_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StepClockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StepClockRequest) ProtoMessage() {}
+
+func (x *StepClockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_telematics_data_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StepClockRequest.ProtoReflect.Descriptor instead.
+func (*StepClockRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_telematics_data_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *StepClockRequest) GetTicks() int32 {
+	if x != nil {
+		return x.Ticks
+	}
+	return 0
+}
+
+type JumpClockRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Duration *durationpb.Duration `protobuf:"bytes,1,opt,name=duration,proto3" json:"duration,omitempty"`
+}
+
+func (x *JumpClockRequest) Reset() {
+	*x = JumpClockRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_telematics_data_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JumpClockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JumpClockRequest) ProtoMessage() {}
+
+func (x *JumpClockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_telematics_data_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JumpClockRequest.ProtoReflect.Descriptor instead.
+func (*JumpClockRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_telematics_data_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *JumpClockRequest) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+// A command to the device of a vehicle. command_id is generated when empty.
+// interval is the reporting interval of COMMAND_SET_INTERVAL, zero restores
+// the random time step. immobilise false releases the vehicle. output and
+// output_on are the output switched by COMMAND_SET_OUTPUT.
+type CommandRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VehicleId  int32                `protobuf:"varint,1,opt,name=vehicle_id,json=vehicleId,proto3" json:"vehicle_id,omitempty"`
+	CommandId  string               `protobuf:"bytes,2,opt,name=command_id,json=commandId,proto3" json:"command_id,omitempty"`
+	Type       CommandType          `protobuf:"varint,3,opt,name=type,proto3,enum=proto.CommandType" json:"type,omitempty"`
+	Interval   *durationpb.Duration `protobuf:"bytes,4,opt,name=interval,proto3" json:"interval,omitempty"`
+	Immobilise bool                 `protobuf:"varint,5,opt,name=immobilise,proto3" json:"immobilise,omitempty"`
+	Output     int32                `protobuf:"varint,6,opt,name=output,proto3" json:"output,omitempty"`
+	OutputOn   bool                 `protobuf:"varint,7,opt,name=output_on,json=outputOn,proto3" json:"output_on,omitempty"`
+}
+
+func (x *CommandRequest) Reset() {
+	*x = CommandRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_telematics_data_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommandRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommandRequest) ProtoMessage() {}
+
+func (x *CommandRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_telematics_data_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommandRequest.ProtoReflect.Descriptor instead.
+func (*CommandRequest) Descriptor() ([]byte, []int) {
+	return file_protobuf_telematics_data_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *CommandRequest) GetVehicleId() int32 {
+	if x != nil {
+		return x.VehicleId
+	}
+	return 0
+}
+
+func (x *CommandRequest) GetCommandId() string {
+	if x != nil {
+		return x.CommandId
+	}
+	return ""
+}
+
+func (x *CommandRequest) GetType() CommandType {
+	if x != nil {
+		return x.Type
+	}
+	return CommandType_COMMAND_SET_INTERVAL
+}
+
+func (x *CommandRequest) GetInterval() *durationpb.Duration {
+	if x != nil {
+		return x.Interval
+	}
+	return nil
+}
+
+func (x *CommandRequest) GetImmobilise() bool {
+	if x != nil {
+		return x.Immobilise
+	}
+	return false
+}
+
+func (x *CommandRequest) GetOutput() int32 {
+	if x != nil {
+		return x.Output
+	}
+	return 0
+}
+
+func (x *CommandRequest) GetOutputOn() bool {
+	if x != nil {
+		return x.OutputOn
+	}
+	return false
+}
+
+type CommandReceipt struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommandId string `protobuf:"bytes,1,opt,name=command_id,json=commandId,proto3" json:"command_id,omitempty"`
+	QueuedAt  int64  `protobuf:"varint,2,opt,name=queued_at,json=queuedAt,proto3" json:"queued_at,omitempty"`
+	ApplyAt   int64  `protobuf:"varint,3,opt,name=apply_at,json=applyAt,proto3" json:"apply_at,omitempty"`
+}
+
+func (x *CommandReceipt) Reset() {
+	*x = CommandReceipt{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_telematics_data_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommandReceipt) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommandReceipt) ProtoMessage() {}
+
+func (x *CommandReceipt) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_telematics_data_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommandReceipt.ProtoReflect.Descriptor instead.
+func (*CommandReceipt) Descriptor() ([]byte, []int) {
+	return file_protobuf_telematics_data_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *CommandReceipt) GetCommandId() string {
+	if x != nil {
+		return x.CommandId
+	}
+	return ""
+}
+
+func (x *CommandReceipt) GetQueuedAt() int64 {
+	if x != nil {
+		return x.QueuedAt
+	}
+	return 0
+}
+
+func (x *CommandReceipt) GetApplyAt() int64 {
+	if x != nil {
+		return x.ApplyAt
+	}
+	return 0
+}
+
+// Published to the commands topic once a command is applied or fails.
+// position is the record reported for COMMAND_REQUEST_POSITION.
+type CommandAck struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CommandId string               `protobuf:"bytes,1,opt,name=command_id,json=commandId,proto3" json:"command_id,omitempty"`
+	VehicleId int32                `protobuf:"varint,2,opt,name=vehicle_id,json=vehicleId,proto3" json:"vehicle_id,omitempty"`
+	Type      CommandType          `protobuf:"varint,3,opt,name=type,proto3,enum=proto.CommandType" json:"type,omitempty"`
+	Status    CommandStatus        `protobuf:"varint,4,opt,name=status,proto3,enum=proto.CommandStatus" json:"status,omitempty"`
+	QueuedAt  int64                `protobuf:"varint,5,opt,name=queued_at,json=queuedAt,proto3" json:"queued_at,omitempty"`
+	AppliedAt int64                `protobuf:"varint,6,opt,name=applied_at,json=appliedAt,proto3" json:"applied_at,omitempty"`
+	Error     string               `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	Position  *TelematicsDataProto `protobuf:"bytes,8,opt,name=position,proto3" json:"position,omitempty"`
+}
+
+func (x *CommandAck) Reset() {
+	*x = CommandAck{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_protobuf_telematics_data_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CommandAck) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommandAck) ProtoMessage() {}
+
+func (x *CommandAck) ProtoReflect() protoreflect.Message {
+	mi := &file_protobuf_telematics_data_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommandAck.ProtoReflect.Descriptor instead.
+func (*CommandAck) Descriptor() ([]byte, []int) {
+	return file_protobuf_telematics_data_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *CommandAck) GetCommandId() string {
+	if x != nil {
+		return x.CommandId
+	}
+	return ""
+}
+
+func (x *CommandAck) GetVehicleId() int32 {
+	if x != nil {
+		return x.VehicleId
+	}
+	return 0
+}
+
+func (x *CommandAck) GetType() CommandType {
+	if x != nil {
+		return x.Type
+	}
+	return CommandType_COMMAND_SET_INTERVAL
+}
+
+func (x *CommandAck) GetStatus() CommandStatus {
+	if x != nil {
+		return x.Status
+	}
+	return CommandStatus_COMMAND_STATUS_APPLIED
+}
+
+func (x *CommandAck) GetQueuedAt() int64 {
+	if x != nil {
+		return x.QueuedAt
+	}
+	return 0
+}
+
+func (x *CommandAck) GetAppliedAt() int64 {
+	if x != nil {
+		return x.AppliedAt
+	}
+	return 0
+}
+
+func (x *CommandAck) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *CommandAck) GetPosition() *TelematicsDataProto {
+	if x != nil {
+		return x.Position
+	}
+	return nil
+}
+
+var File_protobuf_telematics_data_proto protoreflect.FileDescriptor
+
+var file_protobuf_telematics_data_proto_rawDesc = []byte{
+	0x0a, 0x1e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x65, 0x6c, 0x65, 0x6d,
+	0x61, 0x74, 0x69, 0x63, 0x73, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x05, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc9, 0x01, 0x0a, 0x13, 0x54, 0x65, 0x6c, 0x65, 0x6d,
+	0x61, 0x74, 0x69, 0x63, 0x73, 0x44, 0x61, 0x74, 0x61, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x1d,
+	0x0a, 0x0a, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x09, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1c, 0x0a,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x73,
+	0x70, 0x65, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x70, 0x65, 0x65,
+	0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x72,
+	0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x52, 0x06, 0x72, 0x6f, 0x6c, 0x6c,
+	0x75, 0x70, 0x22, 0xf4, 0x01, 0x0a, 0x06, 0x52, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x12, 0x21, 0x0a,
+	0x0c, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x12, 0x31, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73,
+	0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e,
+	0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x69,
+	0x6e, 0x53, 0x70, 0x65, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x76, 0x67, 0x5f, 0x73, 0x70,
+	0x65, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x61, 0x76, 0x67, 0x53, 0x70,
+	0x65, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x53, 0x70, 0x65, 0x65, 0x64,
+	0x12, 0x27, 0x0a, 0x0f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6d, 0x65, 0x74,
+	0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x64, 0x69, 0x73, 0x74, 0x61,
+	0x6e, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x22, 0xc1, 0x03, 0x0a, 0x10, 0x52, 0x61,
+	0x6e, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25,
+	0x0a, 0x0e, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x6f, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x31, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x6f,
+	0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0a, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x76,
+	0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x05,
+	0x52, 0x0a, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x49, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x12, 0x22, 0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52,
+	0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61,
+	0x73, 0x6b, 0x52, 0x06, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x46, 0x0a, 0x11, 0x72, 0x65, 0x73,
+	0x61, 0x6d, 0x70, 0x6c, 0x65, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x10, 0x72, 0x65, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61,
+	0x6c, 0x12, 0x3a, 0x0a, 0x19, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x69, 0x66, 0x79, 0x5f, 0x74, 0x6f,
+	0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x17, 0x73, 0x69, 0x6d, 0x70, 0x6c, 0x69, 0x66, 0x79, 0x54, 0x6f,
+	0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x22, 0x44, 0x0a,
+	0x08, 0x47, 0x65, 0x6f, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74,
+	0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x61, 0x74,
+	0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75,
+	0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74,
+	0x75, 0x64, 0x65, 0x22, 0x53, 0x0a, 0x0b, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42,
+	0x6f, 0x78, 0x12, 0x21, 0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x6f, 0x50, 0x6f, 0x69, 0x6e, 0x74,
+	0x52, 0x03, 0x6d, 0x69, 0x6e, 0x12, 0x21, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x6f, 0x50, 0x6f,
+	0x69, 0x6e, 0x74, 0x52, 0x03, 0x6d, 0x61, 0x78, 0x22, 0x32, 0x0a, 0x07, 0x50, 0x6f, 0x6c, 0x79,
+	0x67, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x6f, 0x50,
+	0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x56, 0x0a, 0x06,
+	0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x12, 0x27, 0x0a, 0x06, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47,
+	0x65, 0x6f, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x06, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x12,
+	0x23, 0x0a, 0x0d, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x5f, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x4d, 0x65,
+	0x74, 0x65, 0x72, 0x73, 0x22, 0xf1, 0x01, 0x0a, 0x0f, 0x41, 0x72, 0x65, 0x61, 0x44, 0x61, 0x74,
+	0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x72, 0x6f, 0x6d,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12,
+	0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x6f, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x12, 0x37, 0x0a, 0x0c, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x62,
+	0x6f, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x6f, 0x78, 0x48, 0x00, 0x52, 0x0b,
+	0x62, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x6f, 0x78, 0x12, 0x2a, 0x0a, 0x07, 0x70,
+	0x6f, 0x6c, 0x79, 0x67, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x6f, 0x6c, 0x79, 0x67, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x07,
+	0x70, 0x6f, 0x6c, 0x79, 0x67, 0x6f, 0x6e, 0x12, 0x27, 0x0a, 0x06, 0x63, 0x69, 0x72, 0x63, 0x6c,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x48, 0x00, 0x52, 0x06, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65,
+	0x42, 0x06, 0x0a, 0x04, 0x61, 0x72, 0x65, 0x61, 0x22, 0xd5, 0x01, 0x0a, 0x10, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a,
+	0x0b, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x05, 0x52, 0x0a, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x49, 0x64, 0x73, 0x12, 0x25,
+	0x0a, 0x0e, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x35, 0x0a, 0x0c, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x5f, 0x62, 0x6f, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x6f, 0x78, 0x52,
+	0x0b, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x6f, 0x78, 0x12, 0x1b, 0x0a, 0x09,
+	0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x08, 0x6d, 0x69, 0x6e, 0x53, 0x70, 0x65, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x72, 0x6f,
+	0x6d, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x22, 0xb2, 0x01, 0x0a, 0x14, 0x46, 0x6c, 0x65, 0x65, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x65, 0x68,
+	0x69, 0x63, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0a,
+	0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x49, 0x64, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x6c,
+	0x65, 0x65, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0d, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x73, 0x12, 0x35, 0x0a, 0x0c, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x62, 0x6f,
+	0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x42, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x6f, 0x78, 0x52, 0x0b, 0x62, 0x6f, 0x75,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x6f, 0x78, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69, 0x6e, 0x5f,
+	0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x69, 0x6e,
+	0x53, 0x70, 0x65, 0x65, 0x64, 0x22, 0x47, 0x0a, 0x0d, 0x46, 0x6c, 0x65, 0x65, 0x74, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x36, 0x0a, 0x08, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x73, 0x44, 0x61, 0x74, 0x61, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x52, 0x08, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x22, 0x35,
+	0x0a, 0x14, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x76, 0x65, 0x68, 0x69,
+	0x63, 0x6c, 0x65, 0x49, 0x64, 0x22, 0xae, 0x01, 0x0a, 0x12, 0x46, 0x6c, 0x65, 0x65, 0x74, 0x41,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x32, 0x0a, 0x07, 0x6d, 0x61,
+	0x78, 0x5f, 0x67, 0x61, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x47, 0x61, 0x70, 0x12, 0x1f,
+	0x0a, 0x0b, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x05, 0x52, 0x0a, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x49, 0x64, 0x73, 0x12,
+	0x25, 0x0a, 0x0e, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x22, 0xb6, 0x01, 0x0a, 0x0f, 0x56, 0x65, 0x68, 0x69, 0x63,
+	0x6c, 0x65, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x08, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x73, 0x44,
+	0x61, 0x74, 0x61, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x70, 0x6f, 0x6c, 0x61, 0x74,
+	0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x70,
+	0x6f, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x6c, 0x69, 0x61, 0x62,
+	0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x6c, 0x69, 0x61, 0x62,
+	0x6c, 0x65, 0x12, 0x2b, 0x0a, 0x03, 0x67, 0x61, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x03, 0x67, 0x61, 0x70, 0x22,
+	0x41, 0x0a, 0x0b, 0x46, 0x6c, 0x65, 0x65, 0x74, 0x41, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x32,
+	0x0a, 0x08, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65,
+	0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c,
+	0x65, 0x73, 0x22, 0x9f, 0x01, 0x0a, 0x13, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x72,
+	0x6f, 0x6d, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x6f, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x5f,
+	0x69, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0a, 0x76, 0x65, 0x68, 0x69, 0x63,
+	0x6c, 0x65, 0x49, 0x64, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x64, 0x6c, 0x65, 0x5f, 0x73, 0x70,
+	0x65, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x69, 0x64, 0x6c, 0x65, 0x53,
+	0x70, 0x65, 0x65, 0x64, 0x22, 0xb0, 0x02, 0x0a, 0x0c, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x76, 0x65, 0x68, 0x69, 0x63,
+	0x6c, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x69,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0e, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x65, 0x74,
+	0x65, 0x72, 0x73, 0x12, 0x3a, 0x0a, 0x0b, 0x6d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6d, 0x6f, 0x76, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x36, 0x0a, 0x09, 0x69, 0x64, 0x6c, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x69,
+	0x64, 0x6c, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x73,
+	0x70, 0x65, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x53,
+	0x70, 0x65, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x76, 0x67, 0x5f, 0x73, 0x70, 0x65, 0x65,
+	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x61, 0x76, 0x67, 0x53, 0x70, 0x65, 0x65,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x6f, 0x70, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x05, 0x73, 0x74, 0x6f, 0x70, 0x73, 0x22, 0x72, 0x0a, 0x14, 0x56, 0x65, 0x68, 0x69, 0x63,
+	0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x2f, 0x0a, 0x08, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x08, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x73,
+	0x12, 0x29, 0x0a, 0x05, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x05, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x22, 0x76, 0x0a, 0x0b, 0x54,
+	0x72, 0x69, 0x70, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x22, 0x0a, 0x0a, 0x69, 0x64,
+	0x6c, 0x65, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00,
+	0x52, 0x09, 0x69, 0x64, 0x6c, 0x65, 0x53, 0x70, 0x65, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x34,
+	0x0a, 0x08, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x74, 0x6f, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x6d, 0x69, 0x6e,
+	0x53, 0x74, 0x6f, 0x70, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x69, 0x64, 0x6c, 0x65, 0x5f, 0x73, 0x70,
+	0x65, 0x65, 0x64, 0x22, 0xab, 0x01, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x69, 0x70,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x72, 0x6f, 0x6d,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12,
+	0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x6f, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0a, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65,
+	0x49, 0x64, 0x73, 0x12, 0x2c, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x72, 0x69,
+	0x70, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x22, 0x36, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x69, 0x70, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x05, 0x74, 0x72, 0x69, 0x70, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x72,
+	0x69, 0x70, 0x52, 0x05, 0x74, 0x72, 0x69, 0x70, 0x73, 0x22, 0x57, 0x0a, 0x0e, 0x47, 0x65, 0x74,
+	0x54, 0x72, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x74,
+	0x72, 0x69, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x72,
+	0x69, 0x70, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x72,
+	0x69, 0x70, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x22, 0xe9, 0x02, 0x0a, 0x04, 0x54, 0x72, 0x69, 0x70, 0x12, 0x17, 0x0a, 0x07, 0x74,
+	0x72, 0x69, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x72,
+	0x69, 0x70, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c,
+	0x65, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x65, 0x6c, 0x65, 0x6d,
+	0x61, 0x74, 0x69, 0x63, 0x73, 0x44, 0x61, 0x74, 0x61, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x05,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x65, 0x6c, 0x65, 0x6d,
+	0x61, 0x74, 0x69, 0x63, 0x73, 0x44, 0x61, 0x74, 0x61, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x03,
+	0x65, 0x6e, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x64, 0x69, 0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x5f,
+	0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x64, 0x69,
+	0x73, 0x74, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x35, 0x0a, 0x08,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x53, 0x70, 0x65, 0x65, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x6f, 0x6e, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x6f, 0x6e, 0x67, 0x6f, 0x69, 0x6e, 0x67, 0x12, 0x32, 0x0a, 0x06, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x73, 0x44, 0x61, 0x74,
+	0x61, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x06, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x22, 0x56,
+	0x0a, 0x09, 0x54, 0x72, 0x69, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x54, 0x72, 0x69, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x04, 0x74, 0x72, 0x69, 0x70, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x72, 0x69, 0x70,
+	0x52, 0x04, 0x74, 0x72, 0x69, 0x70, 0x22, 0xde, 0x02, 0x0a, 0x0c, 0x53, 0x74, 0x6f, 0x70, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x72, 0x6f, 0x6d, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x21,
+	0x0a, 0x0c, 0x74, 0x6f, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x6f, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x52, 0x0a, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x49,
+	0x64, 0x73, 0x12, 0x22, 0x0a, 0x0a, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x48, 0x00, 0x52, 0x09, 0x73, 0x74, 0x6f, 0x70, 0x53, 0x70,
+	0x65, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73,
+	0x5f, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x72,
+	0x61, 0x64, 0x69, 0x75, 0x73, 0x4d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x3c, 0x0a, 0x0c, 0x6d,
+	0x69, 0x6e, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x6d, 0x69,
+	0x6e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x13, 0x70, 0x6c, 0x61,
+	0x63, 0x65, 0x5f, 0x72, 0x61, 0x64, 0x69, 0x75, 0x73, 0x5f, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x11, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x61, 0x64,
+	0x69, 0x75, 0x73, 0x4d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e,
+	0x5f, 0x76, 0x69, 0x73, 0x69, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6d,
+	0x69, 0x6e, 0x56, 0x69, 0x73, 0x69, 0x74, 0x73, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x73, 0x74, 0x6f,
+	0x70, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x22, 0xd3, 0x01, 0x0a, 0x04, 0x53, 0x74, 0x6f, 0x70,
+	0x12, 0x2b, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x6f, 0x50, 0x6f,
+	0x69, 0x6e, 0x74, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a,
+	0x07, 0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x61, 0x72, 0x72, 0x69, 0x76, 0x61, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x70, 0x61, 0x72,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x64, 0x65, 0x70, 0x61,
+	0x72, 0x74, 0x75, 0x72, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x49, 0x64, 0x22, 0x98, 0x01,
+	0x0a, 0x05, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x63, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x70, 0x6c, 0x61, 0x63, 0x65,
+	0x49, 0x64, 0x12, 0x2b, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x6f,
+	0x50, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x16, 0x0a, 0x06, 0x76, 0x69, 0x73, 0x69, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x06, 0x76, 0x69, 0x73, 0x69, 0x74, 0x73, 0x12, 0x2f, 0x0a, 0x05, 0x64, 0x77, 0x65, 0x6c, 0x6c,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x05, 0x64, 0x77, 0x65, 0x6c, 0x6c, 0x22, 0x76, 0x0a, 0x0c, 0x56, 0x65, 0x68, 0x69,
+	0x63, 0x6c, 0x65, 0x53, 0x74, 0x6f, 0x70, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x65, 0x68, 0x69,
+	0x63, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x76, 0x65,
+	0x68, 0x69, 0x63, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x05, 0x73, 0x74, 0x6f, 0x70, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53,
+	0x74, 0x6f, 0x70, 0x52, 0x05, 0x73, 0x74, 0x6f, 0x70, 0x73, 0x12, 0x24, 0x0a, 0x06, 0x70, 0x6c,
+	0x61, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x50, 0x6c, 0x61, 0x63, 0x65, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x73,
+	0x22, 0x40, 0x0a, 0x0d, 0x53, 0x74, 0x6f, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x2f, 0x0a, 0x08, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x65, 0x68, 0x69,
+	0x63, 0x6c, 0x65, 0x53, 0x74, 0x6f, 0x70, 0x73, 0x52, 0x08, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c,
+	0x65, 0x73, 0x22, 0xb4, 0x02, 0x0a, 0x0e, 0x48, 0x65, 0x61, 0x74, 0x6d, 0x61, 0x70, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x66,
+	0x72, 0x6f, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x21, 0x0a, 0x0c,
+	0x74, 0x6f, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x74, 0x6f, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12,
+	0x23, 0x0a, 0x04, 0x67, 0x72, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x72, 0x69, 0x64, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
+	0x67, 0x72, 0x69, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x67, 0x65, 0x6f, 0x68, 0x61, 0x73, 0x68, 0x5f,
+	0x70, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x10, 0x67, 0x65, 0x6f, 0x68, 0x61, 0x73, 0x68, 0x50, 0x72, 0x65, 0x63, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x28, 0x0a, 0x10, 0x63, 0x65, 0x6c, 0x6c, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x5f, 0x6d,
+	0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0e, 0x63, 0x65, 0x6c,
+	0x6c, 0x53, 0x69, 0x7a, 0x65, 0x4d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x66,
+	0x6c, 0x65, 0x65, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x73, 0x12, 0x35, 0x0a, 0x0c, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x62,
+	0x6f, 0x78, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x6f, 0x78, 0x52, 0x0b, 0x62, 0x6f,
+	0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x42, 0x6f, 0x78, 0x22, 0x9f, 0x01, 0x0a, 0x0b, 0x48, 0x65,
+	0x61, 0x74, 0x6d, 0x61, 0x70, 0x43, 0x65, 0x6c, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x65, 0x6c,
+	0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x65, 0x6c, 0x6c,
+	0x49, 0x64, 0x12, 0x27, 0x0a, 0x06, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x6f, 0x50, 0x6f,
+	0x69, 0x6e, 0x74, 0x52, 0x06, 0x63, 0x65, 0x6e, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x76, 0x67, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x61, 0x76, 0x67, 0x53, 0x70, 0x65, 0x65, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x53, 0x70, 0x65, 0x65, 0x64, 0x22, 0x49, 0x0a, 0x07, 0x48,
+	0x65, 0x61, 0x74, 0x6d, 0x61, 0x70, 0x12, 0x28, 0x0a, 0x05, 0x63, 0x65, 0x6c, 0x6c, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x65,
+	0x61, 0x74, 0x6d, 0x61, 0x70, 0x43, 0x65, 0x6c, 0x6c, 0x52, 0x05, 0x63, 0x65, 0x6c, 0x6c, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x22, 0x8e, 0x01, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x56, 0x65,
+	0x68, 0x69, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x09, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d,
+	0x61, 0x78, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
+	0x6d, 0x61, 0x78, 0x53, 0x70, 0x65, 0x65, 0x64, 0x12, 0x3d, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x54,
+	0x69, 0x6d, 0x65, 0x53, 0x74, 0x65, 0x70, 0x22, 0x2f, 0x0a, 0x0e, 0x56, 0x65, 0x68, 0x69, 0x63,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x65, 0x68,
+	0x69, 0x63, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x76,
+	0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x49, 0x64, 0x22, 0x8f, 0x01, 0x0a, 0x12, 0x54, 0x75, 0x6e,
+	0x65, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x09, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x53, 0x70, 0x65, 0x65, 0x64, 0x12, 0x3d, 0x0a, 0x0d, 0x6d,
+	0x61, 0x78, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x6d,
+	0x61, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x65, 0x70, 0x22, 0xa4, 0x03, 0x0a, 0x0d, 0x56,
+	0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
+	0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x09, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70,
+	0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x75,
+	0x73, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x70, 0x65, 0x65, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x53, 0x70, 0x65, 0x65, 0x64,
+	0x12, 0x3d, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x73, 0x74, 0x65,
+	0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x53, 0x74, 0x65, 0x70, 0x12,
+	0x23, 0x0a, 0x0d, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x6c, 0x61, 0x73, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x65, 0x6c, 0x65, 0x6d,
+	0x61, 0x74, 0x69, 0x63, 0x73, 0x44, 0x61, 0x74, 0x61, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x04,
+	0x6c, 0x61, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6f, 0x64, 0x6f, 0x6d, 0x65, 0x74, 0x65, 0x72,
+	0x5f, 0x6b, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x6f, 0x64, 0x6f, 0x6d, 0x65,
+	0x74, 0x65, 0x72, 0x4b, 0x6d, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x6e, 0x5f, 0x74, 0x72, 0x69, 0x70,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x6e, 0x54, 0x72, 0x69, 0x70, 0x12, 0x35,
+	0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x69, 0x6d, 0x6d, 0x6f, 0x62, 0x69, 0x6c,
+	0x69, 0x73, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x69, 0x6d, 0x6d, 0x6f,
+	0x62, 0x69, 0x6c, 0x69, 0x73, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x73, 0x22, 0x62, 0x0a, 0x0b, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x5f, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x66, 0x6c, 0x65, 0x65, 0x74, 0x50, 0x61, 0x75,
+	0x73, 0x65, 0x64, 0x12, 0x30, 0x0a, 0x08, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x65,
+	0x68, 0x69, 0x63, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x08, 0x76, 0x65, 0x68,
+	0x69, 0x63, 0x6c, 0x65, 0x73, 0x22, 0x7d, 0x0a, 0x0a, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6e, 0x6f, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x03, 0x6e, 0x6f, 0x77, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a,
+	0x06, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70,
+	0x61, 0x75, 0x73, 0x65, 0x64, 0x12, 0x2d, 0x0a, 0x04, 0x74, 0x69, 0x63, 0x6b, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04,
+	0x74, 0x69, 0x63, 0x6b, 0x22, 0x2e, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x43, 0x6c, 0x6f, 0x63, 0x6b,
+	0x53, 0x70, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x66, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x22, 0x28, 0x0a, 0x10, 0x53, 0x74, 0x65, 0x70, 0x43, 0x6c, 0x6f, 0x63,
+	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x63, 0x6b,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x74, 0x69, 0x63, 0x6b, 0x73, 0x22, 0x49,
+	0x0a, 0x10, 0x4a, 0x75, 0x6d, 0x70, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x35, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x82, 0x02, 0x0a, 0x0e, 0x43, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x09, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63,
+	0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x35, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6d, 0x6d,
+	0x6f, 0x62, 0x69, 0x6c, 0x69, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69,
+	0x6d, 0x6d, 0x6f, 0x62, 0x69, 0x6c, 0x69, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x6f, 0x6e, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x4f, 0x6e, 0x22, 0x67,
+	0x0a, 0x0e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x49, 0x64, 0x12,
+	0x1b, 0x0a, 0x09, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x71, 0x75, 0x65, 0x75, 0x65, 0x64, 0x41, 0x74, 0x12, 0x19, 0x0a, 0x08,
+	0x61, 0x70, 0x70, 0x6c, 0x79, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x61, 0x70, 0x70, 0x6c, 0x79, 0x41, 0x74, 0x22, 0xaa, 0x02, 0x0a, 0x0a, 0x43, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x41, 0x63, 0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e,
+	0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x76, 0x65, 0x68, 0x69, 0x63,
+	0x6c, 0x65, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61,
+	0x6e, 0x64, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2c, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x71, 0x75,
+	0x65, 0x75, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x71,
+	0x75, 0x65, 0x75, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x70, 0x70, 0x6c, 0x69,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x65, 0x64, 0x41, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x36, 0x0a, 0x08,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x63,
+	0x73, 0x44, 0x61, 0x74, 0x61, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x2a, 0x4c, 0x0a, 0x0a, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x0e, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x55, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x52, 0x41, 0x57, 0x10, 0x00, 0x12, 0x15, 0x0a, 0x11, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x55,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x49, 0x4e, 0x55, 0x54, 0x45, 0x10, 0x01, 0x12, 0x13, 0x0a,
+	0x0f, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x48, 0x4f, 0x55, 0x52,
+	0x10, 0x02, 0x2a, 0x26, 0x0a, 0x05, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x0a, 0x4f,
+	0x52, 0x44, 0x45, 0x52, 0x5f, 0x44, 0x45, 0x53, 0x43, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x4f,
+	0x52, 0x44, 0x45, 0x52, 0x5f, 0x41, 0x53, 0x43, 0x10, 0x01, 0x2a, 0x3d, 0x0a, 0x0d, 0x54, 0x72,
+	0x69, 0x70, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x12, 0x54,
+	0x52, 0x49, 0x50, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x54, 0x52, 0x49, 0x50, 0x5f, 0x45, 0x56, 0x45, 0x4e,
+	0x54, 0x5f, 0x45, 0x4e, 0x44, 0x45, 0x44, 0x10, 0x01, 0x2a, 0x3f, 0x0a, 0x08, 0x47, 0x72, 0x69,
+	0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x47, 0x52, 0x49, 0x44, 0x5f, 0x47, 0x45,
+	0x4f, 0x48, 0x41, 0x53, 0x48, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x47, 0x52, 0x49, 0x44, 0x5f,
+	0x53, 0x51, 0x55, 0x41, 0x52, 0x45, 0x10, 0x01, 0x12, 0x10, 0x0a, 0x0c, 0x47, 0x52, 0x49, 0x44,
+	0x5f, 0x48, 0x45, 0x58, 0x41, 0x47, 0x4f, 0x4e, 0x10, 0x02, 0x2a, 0x75, 0x0a, 0x0b, 0x43, 0x6f,
+	0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x4f, 0x4d,
+	0x4d, 0x41, 0x4e, 0x44, 0x5f, 0x53, 0x45, 0x54, 0x5f, 0x49, 0x4e, 0x54, 0x45, 0x52, 0x56, 0x41,
+	0x4c, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x43, 0x4f, 0x4d, 0x4d, 0x41, 0x4e, 0x44, 0x5f, 0x49,
+	0x4d, 0x4d, 0x4f, 0x42, 0x49, 0x4c, 0x49, 0x53, 0x45, 0x10, 0x01, 0x12, 0x1c, 0x0a, 0x18, 0x43,
+	0x4f, 0x4d, 0x4d, 0x41, 0x4e, 0x44, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x5f, 0x50,
+	0x4f, 0x53, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x16, 0x0a, 0x12, 0x43, 0x4f, 0x4d,
+	0x4d, 0x41, 0x4e, 0x44, 0x5f, 0x53, 0x45, 0x54, 0x5f, 0x4f, 0x55, 0x54, 0x50, 0x55, 0x54, 0x10,
+	0x03, 0x2a, 0x46, 0x0a, 0x0d, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x1a, 0x0a, 0x16, 0x43, 0x4f, 0x4d, 0x4d, 0x41, 0x4e, 0x44, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x50, 0x50, 0x4c, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x19,
+	0x0a, 0x15, 0x43, 0x4f, 0x4d, 0x4d, 0x41, 0x4e, 0x44, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x32, 0xb2, 0x06, 0x0a, 0x15, 0x54, 0x65,
+	0x6c, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x73, 0x44, 0x61, 0x74, 0x61, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x43, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74,
+	0x44, 0x61, 0x74, 0x61, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1a, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x73, 0x44,
+	0x61, 0x74, 0x61, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x45, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x52,
+	0x61, 0x6e, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x61,
+	0x74, 0x69, 0x63, 0x73, 0x44, 0x61, 0x74, 0x61, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x30, 0x01, 0x12,
+	0x43, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x41, 0x72, 0x65, 0x61, 0x44, 0x61, 0x74, 0x61, 0x12, 0x16,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x72, 0x65, 0x61, 0x44, 0x61, 0x74, 0x61, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54,
+	0x65, 0x6c, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x73, 0x44, 0x61, 0x74, 0x61, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x30, 0x01, 0x12, 0x4c, 0x0a, 0x13, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x73, 0x12, 0x17, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x65, 0x6c,
+	0x65, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x73, 0x44, 0x61, 0x74, 0x61, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x30, 0x01, 0x12, 0x45, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x46, 0x6c, 0x65, 0x65, 0x74, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46,
+	0x6c, 0x65, 0x65, 0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x6c, 0x65, 0x65,
+	0x74, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x4b, 0x0a, 0x10, 0x47, 0x65, 0x74,
+	0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x4c, 0x61, 0x74, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x4c, 0x61, 0x74,
+	0x65, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x54, 0x65, 0x6c, 0x65, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x73, 0x44, 0x61, 0x74,
+	0x61, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x3f, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x46, 0x6c, 0x65,
+	0x65, 0x74, 0x41, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x46, 0x6c, 0x65, 0x65, 0x74, 0x41, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x46, 0x6c, 0x65, 0x65,
+	0x74, 0x41, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x4a, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x56, 0x65,
+	0x68, 0x69, 0x63, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x1a, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x56,
+	0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x69, 0x70, 0x73,
+	0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x69,
+	0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x54, 0x72, 0x69, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2d, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x54, 0x72, 0x69, 0x70, 0x12, 0x15,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x69, 0x70, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x72,
+	0x69, 0x70, 0x12, 0x35, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x70, 0x73, 0x12, 0x13,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x6f, 0x70,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x0a, 0x47, 0x65, 0x74,
+	0x48, 0x65, 0x61, 0x74, 0x6d, 0x61, 0x70, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x48, 0x65, 0x61, 0x74, 0x6d, 0x61, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0e,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x65, 0x61, 0x74, 0x6d, 0x61, 0x70, 0x32, 0x94,
+	0x07, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x12, 0x3c, 0x0a, 0x0a, 0x41, 0x64, 0x64, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x12,
+	0x18, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x64, 0x64, 0x56, 0x65, 0x68, 0x69, 0x63,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x3e, 0x0a, 0x0d, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65,
+	0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
+	0x3b, 0x0a, 0x0c, 0x50, 0x61, 0x75, 0x73, 0x65, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x12,
+	0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x56,
+	0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3c, 0x0a, 0x0d,
+	0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x12, 0x15, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x65, 0x68,
+	0x69, 0x63, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x38, 0x0a, 0x0a, 0x50, 0x61,
+	0x75, 0x73, 0x65, 0x46, 0x6c, 0x65, 0x65, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x46, 0x6c,
+	0x65, 0x65, 0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x12, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x3e, 0x0a, 0x0b, 0x54, 0x75, 0x6e, 0x65, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x12, 0x19,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x75, 0x6e, 0x65, 0x56, 0x65, 0x68, 0x69, 0x63,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x3a, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x73, 0x12,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x12, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x56, 0x65, 0x68, 0x69, 0x63, 0x6c, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x08, 0x47,
+	0x65, 0x74, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
+	0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x37, 0x0a, 0x0a, 0x50, 0x61, 0x75, 0x73, 0x65, 0x43, 0x6c, 0x6f, 0x63, 0x6b,
+	0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x38, 0x0a, 0x0b, 0x52,
+	0x65, 0x73, 0x75, 0x6d, 0x65, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x1a, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6c, 0x6f, 0x63, 0x6b,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3f, 0x0a, 0x0d, 0x53, 0x65, 0x74, 0x43, 0x6c, 0x6f, 0x63,
+	0x6b, 0x53, 0x70, 0x65, 0x65, 0x64, 0x12, 0x1b, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53,
+	0x65, 0x74, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x70, 0x65, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6c, 0x6f, 0x63,
+	0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x37, 0x0a, 0x09, 0x53, 0x74, 0x65, 0x70, 0x43, 0x6c,
+	0x6f, 0x63, 0x6b, 0x12, 0x17, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x74, 0x65, 0x70,
+	0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x37, 0x0a, 0x09, 0x4a, 0x75, 0x6d, 0x70, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x17, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4a, 0x75, 0x6d, 0x70, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6c,
+	0x6f, 0x63, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3b, 0x0a, 0x0b, 0x53, 0x65, 0x6e, 0x64,
+	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x52, 0x65,
+	0x63, 0x65, 0x69, 0x70, 0x74, 0x42, 0x1f, 0x5a, 0x1d, 0x74, 0x65, 0x6c, 0x65, 0x6d, 0x61, 0x74,
+	0x69, 0x63, 0x73, 0x2d, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_protobuf_telematics_data_proto_rawDescOnce sync.Once
+	file_protobuf_telematics_data_proto_rawDescData = file_protobuf_telematics_data_proto_rawDesc
+)
+
+func file_protobuf_telematics_data_proto_rawDescGZIP() []byte {
+	file_protobuf_telematics_data_proto_rawDescOnce.Do(func() {
+		file_protobuf_telematics_data_proto_rawDescData = protoimpl.X.CompressGZIP(file_protobuf_telematics_data_proto_rawDescData)
+	})
+	return file_protobuf_telematics_data_proto_rawDescData
+}
+
+var file_protobuf_telematics_data_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
+var file_protobuf_telematics_data_proto_msgTypes = make([]protoimpl.MessageInfo, 44)
+var file_protobuf_telematics_data_proto_goTypes = []interface{}{
+	(Resolution)(0),               // 0: proto.Resolution
+	(Order)(0),                    // 1: proto.Order
+	(TripEventType)(0),            // 2: proto.TripEventType
+	(GridType)(0),                 // 3: proto.GridType
+	(CommandType)(0),              // 4: proto.CommandType
+	(CommandStatus)(0),            // 5: proto.CommandStatus
+	(*TelematicsDataProto)(nil),   // 6: proto.TelematicsDataProto
+	(*Rollup)(nil),                // 7: proto.Rollup
+	(*RangeDataRequest)(nil),      // 8: proto.RangeDataRequest
+	(*GeoPoint)(nil),              // 9: proto.GeoPoint
+	(*BoundingBox)(nil),           // 10: proto.BoundingBox
+	(*Polygon)(nil),               // 11: proto.Polygon
+	(*Circle)(nil),                // 12: proto.Circle
+	(*AreaDataRequest)(nil),       // 13: proto.AreaDataRequest
+	(*SubscribeRequest)(nil),      // 14: proto.SubscribeRequest
+	(*FleetSnapshotRequest)(nil),  // 15: proto.FleetSnapshotRequest
+	(*FleetSnapshot)(nil),         // 16: proto.FleetSnapshot
+	(*VehicleLatestRequest)(nil),  // 17: proto.VehicleLatestRequest
+	(*FleetAtTimeRequest)(nil),    // 18: proto.FleetAtTimeRequest
+	(*VehiclePosition)(nil),       // 19: proto.VehiclePosition
+	(*FleetAtTime)(nil),           // 20: proto.FleetAtTime
+	(*VehicleStatsRequest)(nil),   // 21: proto.VehicleStatsRequest
+	(*VehicleStats)(nil),          // 22: proto.VehicleStats
+	(*VehicleStatsResponse)(nil),  // 23: proto.VehicleStatsResponse
+	(*TripOptions)(nil),           // 24: proto.TripOptions
+	(*ListTripsRequest)(nil),      // 25: proto.ListTripsRequest
+	(*ListTripsResponse)(nil),     // 26: proto.ListTripsResponse
+	(*GetTripRequest)(nil),        // 27: proto.GetTripRequest
+	(*Trip)(nil),                  // 28: proto.Trip
+	(*TripEvent)(nil),             // 29: proto.TripEvent
+	(*StopsRequest)(nil),          // 30: proto.StopsRequest
+	(*Stop)(nil),                  // 31: proto.Stop
+	(*Place)(nil),                 // 32: proto.Place
+	(*VehicleStops)(nil),          // 33: proto.VehicleStops
+	(*StopsResponse)(nil),         // 34: proto.StopsResponse
+	(*HeatmapRequest)(nil),        // 35: proto.HeatmapRequest
+	(*HeatmapCell)(nil),           // 36: proto.HeatmapCell
+	(*Heatmap)(nil),               // 37: proto.Heatmap
+	(*AddVehicleRequest)(nil),     // 38: proto.AddVehicleRequest
+	(*VehicleRequest)(nil),        // 39: proto.VehicleRequest
+	(*TuneVehicleRequest)(nil),    // 40: proto.TuneVehicleRequest
+	(*VehicleStatus)(nil),         // 41: proto.VehicleStatus
+	(*VehicleList)(nil),           // 42: proto.VehicleList
+	(*ClockState)(nil),            // 43: proto.ClockState
+	(*SetClockSpeedRequest)(nil),  // 44: proto.SetClockSpeedRequest
+	(*StepClockRequest)(nil),      // 45: proto.StepClockRequest
+	(*JumpClockRequest)(nil),      // 46: proto.JumpClockRequest
+	(*CommandRequest)(nil),        // 47: proto.CommandRequest
+	(*CommandReceipt)(nil),        // 48: proto.CommandReceipt
+	(*CommandAck)(nil),            // 49: proto.CommandAck
+	(*fieldmaskpb.FieldMask)(nil), // 50: google.protobuf.FieldMask
+	(*durationpb.Duration)(nil),   // 51: google.protobuf.Duration
+	(*emptypb.Empty)(nil),         // 52: google.protobuf.Empty
+}
+var file_protobuf_telematics_data_proto_depIdxs = []int32{
+	7,  // 0: proto.TelematicsDataProto.rollup:type_name -> proto.Rollup
+	0,  // 1: proto.Rollup.resolution:type_name -> proto.Resolution
+	0,  // 2: proto.RangeDataRequest.resolution:type_name -> proto.Resolution
+	1,  // 3: proto.RangeDataRequest.order:type_name -> proto.Order
+	50, // 4: proto.RangeDataRequest.fields:type_name -> google.protobuf.FieldMask
+	51, // 5: proto.RangeDataRequest.resample_interval:type_name -> google.protobuf.Duration
+	9,  // 6: proto.BoundingBox.min:type_name -> proto.GeoPoint
+	9,  // 7: proto.BoundingBox.max:type_name -> proto.GeoPoint
+	9,  // 8: proto.Polygon.points:type_name -> proto.GeoPoint
+	9,  // 9: proto.Circle.center:type_name -> proto.GeoPoint
+	10, // 10: proto.AreaDataRequest.bounding_box:type_name -> proto.BoundingBox
+	11, // 11: proto.AreaDataRequest.polygon:type_name -> proto.Polygon
+	12, // 12: proto.AreaDataRequest.circle:type_name -> proto.Circle
+	10, // 13: proto.SubscribeRequest.bounding_box:type_name -> proto.BoundingBox
+	10, // 14: proto.FleetSnapshotRequest.bounding_box:type_name -> proto.BoundingBox
+	6,  // 15: proto.FleetSnapshot.vehicles:type_name -> proto.TelematicsDataProto
+	51, // 16: proto.FleetAtTimeRequest.max_gap:type_name -> google.protobuf.Duration
+	6,  // 17: proto.VehiclePosition.position:type_name -> proto.TelematicsDataProto
+	51, // 18: proto.VehiclePosition.gap:type_name -> google.protobuf.Duration
+	19, // 19: proto.FleetAtTime.vehicles:type_name -> proto.VehiclePosition
+	51, // 20: proto.VehicleStats.moving_time:type_name -> google.protobuf.Duration
+	51, // 21: proto.VehicleStats.idle_time:type_name -> google.protobuf.Duration
+	22, // 22: proto.VehicleStatsResponse.vehicles:type_name -> proto.VehicleStats
+	22, // 23: proto.VehicleStatsResponse.fleet:type_name -> proto.VehicleStats
+	51, // 24: proto.TripOptions.min_stop:type_name -> google.protobuf.Duration
+	24, // 25: proto.ListTripsRequest.options:type_name -> proto.TripOptions
+	28, // 26: proto.ListTripsResponse.trips:type_name -> proto.Trip
+	24, // 27: proto.GetTripRequest.options:type_name -> proto.TripOptions
+	6,  // 28: proto.Trip.start:type_name -> proto.TelematicsDataProto
+	6,  // 29: proto.Trip.end:type_name -> proto.TelematicsDataProto
+	51, // 30: proto.Trip.duration:type_name -> google.protobuf.Duration
+	6,  // 31: proto.Trip.points:type_name -> proto.TelematicsDataProto
+	2,  // 32: proto.TripEvent.type:type_name -> proto.TripEventType
+	28, // 33: proto.TripEvent.trip:type_name -> proto.Trip
+	51, // 34: proto.StopsRequest.min_duration:type_name -> google.protobuf.Duration
+	9,  // 35: proto.Stop.location:type_name -> proto.GeoPoint
+	51, // 36: proto.Stop.duration:type_name -> google.protobuf.Duration
+	9,  // 37: proto.Place.location:type_name -> proto.GeoPoint
+	51, // 38: proto.Place.dwell:type_name -> google.protobuf.Duration
+	31, // 39: proto.VehicleStops.stops:type_name -> proto.Stop
+	32, // 40: proto.VehicleStops.places:type_name -> proto.Place
+	33, // 41: proto.StopsResponse.vehicles:type_name -> proto.VehicleStops
+	3,  // 42: proto.HeatmapRequest.grid:type_name -> proto.GridType
+	10, // 43: proto.HeatmapRequest.bounding_box:type_name -> proto.BoundingBox
+	9,  // 44: proto.HeatmapCell.center:type_name -> proto.GeoPoint
+	36, // 45: proto.Heatmap.cells:type_name -> proto.HeatmapCell
+	51, // 46: proto.AddVehicleRequest.max_time_step:type_name -> google.protobuf.Duration
+	51, // 47: proto.TuneVehicleRequest.max_time_step:type_name -> google.protobuf.Duration
+	51, // 48: proto.VehicleStatus.max_time_step:type_name -> google.protobuf.Duration
+	6,  // 49: proto.VehicleStatus.last:type_name -> proto.TelematicsDataProto
+	51, // 50: proto.VehicleStatus.interval:type_name -> google.protobuf.Duration
+	41, // 51: proto.VehicleList.vehicles:type_name -> proto.VehicleStatus
+	51, // 52: proto.ClockState.tick:type_name -> google.protobuf.Duration
+	51, // 53: proto.JumpClockRequest.duration:type_name -> google.protobuf.Duration
+	4,  // 54: proto.CommandRequest.type:type_name -> proto.CommandType
+	51, // 55: proto.CommandRequest.interval:type_name -> google.protobuf.Duration
+	4,  // 56: proto.CommandAck.type:type_name -> proto.CommandType
+	5,  // 57: proto.CommandAck.status:type_name -> proto.CommandStatus
+	6,  // 58: proto.CommandAck.position:type_name -> proto.TelematicsDataProto
+	52, // 59: proto.TelematicsDataService.GetLatestData:input_type -> google.protobuf.Empty
+	8,  // 60: proto.TelematicsDataService.GetRangeData:input_type -> proto.RangeDataRequest
+	13, // 61: proto.TelematicsDataService.GetAreaData:input_type -> proto.AreaDataRequest
+	14, // 62: proto.TelematicsDataService.SubscribeTelematics:input_type -> proto.SubscribeRequest
+	15, // 63: proto.TelematicsDataService.GetFleetSnapshot:input_type -> proto.FleetSnapshotRequest
+	17, // 64: proto.TelematicsDataService.GetVehicleLatest:input_type -> proto.VehicleLatestRequest
+	18, // 65: proto.TelematicsDataService.GetFleetAtTime:input_type -> proto.FleetAtTimeRequest
+	21, // 66: proto.TelematicsDataService.GetVehicleStats:input_type -> proto.VehicleStatsRequest
+	25, // 67: proto.TelematicsDataService.ListTrips:input_type -> proto.ListTripsRequest
+	27, // 68: proto.TelematicsDataService.GetTrip:input_type -> proto.GetTripRequest
+	30, // 69: proto.TelematicsDataService.GetStops:input_type -> proto.StopsRequest
+	35, // 70: proto.TelematicsDataService.GetHeatmap:input_type -> proto.HeatmapRequest
+	38, // 71: proto.ControlService.AddVehicle:input_type -> proto.AddVehicleRequest
+	39, // 72: proto.ControlService.RemoveVehicle:input_type -> proto.VehicleRequest
+	39, // 73: proto.ControlService.PauseVehicle:input_type -> proto.VehicleRequest
+	39, // 74: proto.ControlService.ResumeVehicle:input_type -> proto.VehicleRequest
+	52, // 75: proto.ControlService.PauseFleet:input_type -> google.protobuf.Empty
+	52, // 76: proto.ControlService.ResumeFleet:input_type -> google.protobuf.Empty
+	40, // 77: proto.ControlService.TuneVehicle:input_type -> proto.TuneVehicleRequest
+	52, // 78: proto.ControlService.ListVehicles:input_type -> google.protobuf.Empty
+	52, // 79: proto.ControlService.GetClock:input_type -> google.protobuf.Empty
+	52, // 80: proto.ControlService.PauseClock:input_type -> google.protobuf.Empty
+	52, // 81: proto.ControlService.ResumeClock:input_type -> google.protobuf.Empty
+	44, // 82: proto.ControlService.SetClockSpeed:input_type -> proto.SetClockSpeedRequest
+	45, // 83: proto.ControlService.StepClock:input_type -> proto.StepClockRequest
+	46, // 84: proto.ControlService.JumpClock:input_type -> proto.JumpClockRequest
+	47, // 85: proto.ControlService.SendCommand:input_type -> proto.CommandRequest
+	6,  // 86: proto.TelematicsDataService.GetLatestData:output_type -> proto.TelematicsDataProto
+	6,  // 87: proto.TelematicsDataService.GetRangeData:output_type -> proto.TelematicsDataProto
+	6,  // 88: proto.TelematicsDataService.GetAreaData:output_type -> proto.TelematicsDataProto
+	6,  // 89: proto.TelematicsDataService.SubscribeTelematics:output_type -> proto.TelematicsDataProto
+	16, // 90: proto.TelematicsDataService.GetFleetSnapshot:output_type -> proto.FleetSnapshot
+	6,  // 91: proto.TelematicsDataService.GetVehicleLatest:output_type -> proto.TelematicsDataProto
+	20, // 92: proto.TelematicsDataService.GetFleetAtTime:output_type -> proto.FleetAtTime
+	23, // 93: proto.TelematicsDataService.GetVehicleStats:output_type -> proto.VehicleStatsResponse
+	26, // 94: proto.TelematicsDataService.ListTrips:output_type -> proto.ListTripsResponse
+	28, // 95: proto.TelematicsDataService.GetTrip:output_type -> proto.Trip
+	34, // 96: proto.TelematicsDataService.GetStops:output_type -> proto.StopsResponse
+	37, // 97: proto.TelematicsDataService.GetHeatmap:output_type -> proto.Heatmap
+	41, // 98: proto.ControlService.AddVehicle:output_type -> proto.VehicleStatus
+	52, // 99: proto.ControlService.RemoveVehicle:output_type -> google.protobuf.Empty
+	41, // 100: proto.ControlService.PauseVehicle:output_type -> proto.VehicleStatus
+	41, // 101: proto.ControlService.ResumeVehicle:output_type -> proto.VehicleStatus
+	42, // 102: proto.ControlService.PauseFleet:output_type -> proto.VehicleList
+	42, // 103: proto.ControlService.ResumeFleet:output_type -> proto.VehicleList
+	41, // 104: proto.ControlService.TuneVehicle:output_type -> proto.VehicleStatus
+	42, // 105: proto.ControlService.ListVehicles:output_type -> proto.VehicleList
+	43, // 106: proto.ControlService.GetClock:output_type -> proto.ClockState
+	43, // 107: proto.ControlService.PauseClock:output_type -> proto.ClockState
+	43, // 108: proto.ControlService.ResumeClock:output_type -> proto.ClockState
+	43, // 109: proto.ControlService.SetClockSpeed:output_type -> proto.ClockState
+	43, // 110: proto.ControlService.StepClock:output_type -> proto.ClockState
+	43, // 111: proto.ControlService.JumpClock:output_type -> proto.ClockState
+	48, // 112: proto.ControlService.SendCommand:output_type -> proto.CommandReceipt
+	86, // [86:113] is the sub-list for method output_type
+	59, // [59:86] is the sub-list for method input_type
+	59, // [59:59] is the sub-list for extension type_name
+	59, // [59:59] is the sub-list for extension extendee
+	0,  // [0:59] is the sub-list for field type_name
+}
+
+func init() { file_protobuf_telematics_data_proto_init() }
+func file_protobuf_telematics_data_proto_init() {
+	if File_protobuf_telematics_data_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_protobuf_telematics_data_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TelematicsDataProto); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_telematics_data_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Rollup); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_telematics_data_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RangeDataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_telematics_data_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GeoPoint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_telematics_data_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BoundingBox); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_telematics_data_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Polygon); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_telematics_data_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Circle); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_telematics_data_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AreaDataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_telematics_data_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_telematics_data_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FleetSnapshotRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_telematics_data_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FleetSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_telematics_data_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VehicleLatestRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_telematics_data_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FleetAtTimeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_telematics_data_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VehiclePosition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_telematics_data_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FleetAtTime); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_telematics_data_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VehicleStatsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_telematics_data_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VehicleStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_telematics_data_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VehicleStatsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_telematics_data_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TripOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_telematics_data_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTripsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_telematics_data_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListTripsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_telematics_data_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetTripRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_telematics_data_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Trip); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_telematics_data_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TripEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_telematics_data_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_telematics_data_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Stop); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_telematics_data_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Place); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_telematics_data_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VehicleStops); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_telematics_data_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_telematics_data_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeatmapRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_telematics_data_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HeatmapCell); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_telematics_data_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Heatmap); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_telematics_data_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddVehicleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_telematics_data_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VehicleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_telematics_data_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TuneVehicleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_telematics_data_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VehicleStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_telematics_data_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VehicleList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_telematics_data_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClockState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_telematics_data_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetClockSpeedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_telematics_data_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StepClockRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_telematics_data_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JumpClockRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_telematics_data_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommandRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_telematics_data_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommandReceipt); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_protobuf_telematics_data_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CommandAck); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -255,18 +4796,26 @@ func file_protobuf_telematics_data_proto_init() {
 			}
 		}
 	}
+	file_protobuf_telematics_data_proto_msgTypes[7].OneofWrappers = []interface{}{
+		(*AreaDataRequest_BoundingBox)(nil),
+		(*AreaDataRequest_Polygon)(nil),
+		(*AreaDataRequest_Circle)(nil),
+	}
+	file_protobuf_telematics_data_proto_msgTypes[18].OneofWrappers = []interface{}{}
+	file_protobuf_telematics_data_proto_msgTypes[24].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_protobuf_telematics_data_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   2,
+			NumEnums:      6,
+			NumMessages:   44,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_protobuf_telematics_data_proto_goTypes,
 		DependencyIndexes: file_protobuf_telematics_data_proto_depIdxs,
+		EnumInfos:         file_protobuf_telematics_data_proto_enumTypes,
 		MessageInfos:      file_protobuf_telematics_data_proto_msgTypes,
 	}.Build()
 	File_protobuf_telematics_data_proto = out.File
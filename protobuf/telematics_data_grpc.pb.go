@@ -25,6 +25,16 @@ const _ = grpc.SupportPackageIsVersion7
 type TelematicsDataServiceClient interface {
 	GetLatestData(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*TelematicsDataProto, error)
 	GetRangeData(ctx context.Context, in *RangeDataRequest, opts ...grpc.CallOption) (TelematicsDataService_GetRangeDataClient, error)
+	GetAreaData(ctx context.Context, in *AreaDataRequest, opts ...grpc.CallOption) (TelematicsDataService_GetAreaDataClient, error)
+	SubscribeTelematics(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (TelematicsDataService_SubscribeTelematicsClient, error)
+	GetFleetSnapshot(ctx context.Context, in *FleetSnapshotRequest, opts ...grpc.CallOption) (*FleetSnapshot, error)
+	GetVehicleLatest(ctx context.Context, in *VehicleLatestRequest, opts ...grpc.CallOption) (*TelematicsDataProto, error)
+	GetFleetAtTime(ctx context.Context, in *FleetAtTimeRequest, opts ...grpc.CallOption) (*FleetAtTime, error)
+	GetVehicleStats(ctx context.Context, in *VehicleStatsRequest, opts ...grpc.CallOption) (*VehicleStatsResponse, error)
+	ListTrips(ctx context.Context, in *ListTripsRequest, opts ...grpc.CallOption) (*ListTripsResponse, error)
+	GetTrip(ctx context.Context, in *GetTripRequest, opts ...grpc.CallOption) (*Trip, error)
+	GetStops(ctx context.Context, in *StopsRequest, opts ...grpc.CallOption) (*StopsResponse, error)
+	GetHeatmap(ctx context.Context, in *HeatmapRequest, opts ...grpc.CallOption) (*Heatmap, error)
 }
 
 type telematicsDataServiceClient struct {
@@ -76,12 +86,158 @@ func (x *telematicsDataServiceGetRangeDataClient) Recv() (*TelematicsDataProto,
 	return m, nil
 }
 
+func (c *telematicsDataServiceClient) GetAreaData(ctx context.Context, in *AreaDataRequest, opts ...grpc.CallOption) (TelematicsDataService_GetAreaDataClient, error) {
+	stream, err := c.cc.NewStream(ctx, &TelematicsDataService_ServiceDesc.Streams[1], "/proto.TelematicsDataService/GetAreaData", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &telematicsDataServiceGetAreaDataClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TelematicsDataService_GetAreaDataClient interface {
+	Recv() (*TelematicsDataProto, error)
+	grpc.ClientStream
+}
+
+type telematicsDataServiceGetAreaDataClient struct {
+	grpc.ClientStream
+}
+
+func (x *telematicsDataServiceGetAreaDataClient) Recv() (*TelematicsDataProto, error) {
+	m := new(TelematicsDataProto)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *telematicsDataServiceClient) SubscribeTelematics(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (TelematicsDataService_SubscribeTelematicsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &TelematicsDataService_ServiceDesc.Streams[2], "/proto.TelematicsDataService/SubscribeTelematics", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &telematicsDataServiceSubscribeTelematicsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TelematicsDataService_SubscribeTelematicsClient interface {
+	Recv() (*TelematicsDataProto, error)
+	grpc.ClientStream
+}
+
+type telematicsDataServiceSubscribeTelematicsClient struct {
+	grpc.ClientStream
+}
+
+func (x *telematicsDataServiceSubscribeTelematicsClient) Recv() (*TelematicsDataProto, error) {
+	m := new(TelematicsDataProto)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *telematicsDataServiceClient) GetFleetSnapshot(ctx context.Context, in *FleetSnapshotRequest, opts ...grpc.CallOption) (*FleetSnapshot, error) {
+	out := new(FleetSnapshot)
+	err := c.cc.Invoke(ctx, "/proto.TelematicsDataService/GetFleetSnapshot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *telematicsDataServiceClient) GetVehicleLatest(ctx context.Context, in *VehicleLatestRequest, opts ...grpc.CallOption) (*TelematicsDataProto, error) {
+	out := new(TelematicsDataProto)
+	err := c.cc.Invoke(ctx, "/proto.TelematicsDataService/GetVehicleLatest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *telematicsDataServiceClient) GetFleetAtTime(ctx context.Context, in *FleetAtTimeRequest, opts ...grpc.CallOption) (*FleetAtTime, error) {
+	out := new(FleetAtTime)
+	err := c.cc.Invoke(ctx, "/proto.TelematicsDataService/GetFleetAtTime", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *telematicsDataServiceClient) GetVehicleStats(ctx context.Context, in *VehicleStatsRequest, opts ...grpc.CallOption) (*VehicleStatsResponse, error) {
+	out := new(VehicleStatsResponse)
+	err := c.cc.Invoke(ctx, "/proto.TelematicsDataService/GetVehicleStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *telematicsDataServiceClient) ListTrips(ctx context.Context, in *ListTripsRequest, opts ...grpc.CallOption) (*ListTripsResponse, error) {
+	out := new(ListTripsResponse)
+	err := c.cc.Invoke(ctx, "/proto.TelematicsDataService/ListTrips", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *telematicsDataServiceClient) GetTrip(ctx context.Context, in *GetTripRequest, opts ...grpc.CallOption) (*Trip, error) {
+	out := new(Trip)
+	err := c.cc.Invoke(ctx, "/proto.TelematicsDataService/GetTrip", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *telematicsDataServiceClient) GetStops(ctx context.Context, in *StopsRequest, opts ...grpc.CallOption) (*StopsResponse, error) {
+	out := new(StopsResponse)
+	err := c.cc.Invoke(ctx, "/proto.TelematicsDataService/GetStops", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *telematicsDataServiceClient) GetHeatmap(ctx context.Context, in *HeatmapRequest, opts ...grpc.CallOption) (*Heatmap, error) {
+	out := new(Heatmap)
+	err := c.cc.Invoke(ctx, "/proto.TelematicsDataService/GetHeatmap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TelematicsDataServiceServer is the server API for TelematicsDataService service.
 // All implementations must embed UnimplementedTelematicsDataServiceServer
 // for forward compatibility
 type TelematicsDataServiceServer interface {
 	GetLatestData(context.Context, *emptypb.Empty) (*TelematicsDataProto, error)
 	GetRangeData(*RangeDataRequest, TelematicsDataService_GetRangeDataServer) error
+	GetAreaData(*AreaDataRequest, TelematicsDataService_GetAreaDataServer) error
+	SubscribeTelematics(*SubscribeRequest, TelematicsDataService_SubscribeTelematicsServer) error
+	GetFleetSnapshot(context.Context, *FleetSnapshotRequest) (*FleetSnapshot, error)
+	GetVehicleLatest(context.Context, *VehicleLatestRequest) (*TelematicsDataProto, error)
+	GetFleetAtTime(context.Context, *FleetAtTimeRequest) (*FleetAtTime, error)
+	GetVehicleStats(context.Context, *VehicleStatsRequest) (*VehicleStatsResponse, error)
+	ListTrips(context.Context, *ListTripsRequest) (*ListTripsResponse, error)
+	GetTrip(context.Context, *GetTripRequest) (*Trip, error)
+	GetStops(context.Context, *StopsRequest) (*StopsResponse, error)
+	GetHeatmap(context.Context, *HeatmapRequest) (*Heatmap, error)
 	mustEmbedUnimplementedTelematicsDataServiceServer()
 }
 
@@ -95,6 +251,36 @@ func (UnimplementedTelematicsDataServiceServer) GetLatestData(context.Context, *
 func (UnimplementedTelematicsDataServiceServer) GetRangeData(*RangeDataRequest, TelematicsDataService_GetRangeDataServer) error {
 	return status.Errorf(codes.Unimplemented, "method GetRangeData not implemented")
 }
+func (UnimplementedTelematicsDataServiceServer) GetAreaData(*AreaDataRequest, TelematicsDataService_GetAreaDataServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetAreaData not implemented")
+}
+func (UnimplementedTelematicsDataServiceServer) SubscribeTelematics(*SubscribeRequest, TelematicsDataService_SubscribeTelematicsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeTelematics not implemented")
+}
+func (UnimplementedTelematicsDataServiceServer) GetFleetSnapshot(context.Context, *FleetSnapshotRequest) (*FleetSnapshot, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFleetSnapshot not implemented")
+}
+func (UnimplementedTelematicsDataServiceServer) GetVehicleLatest(context.Context, *VehicleLatestRequest) (*TelematicsDataProto, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVehicleLatest not implemented")
+}
+func (UnimplementedTelematicsDataServiceServer) GetFleetAtTime(context.Context, *FleetAtTimeRequest) (*FleetAtTime, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFleetAtTime not implemented")
+}
+func (UnimplementedTelematicsDataServiceServer) GetVehicleStats(context.Context, *VehicleStatsRequest) (*VehicleStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVehicleStats not implemented")
+}
+func (UnimplementedTelematicsDataServiceServer) ListTrips(context.Context, *ListTripsRequest) (*ListTripsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTrips not implemented")
+}
+func (UnimplementedTelematicsDataServiceServer) GetTrip(context.Context, *GetTripRequest) (*Trip, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrip not implemented")
+}
+func (UnimplementedTelematicsDataServiceServer) GetStops(context.Context, *StopsRequest) (*StopsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStops not implemented")
+}
+func (UnimplementedTelematicsDataServiceServer) GetHeatmap(context.Context, *HeatmapRequest) (*Heatmap, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHeatmap not implemented")
+}
 func (UnimplementedTelematicsDataServiceServer) mustEmbedUnimplementedTelematicsDataServiceServer() {}
 
 // UnsafeTelematicsDataServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -147,6 +333,192 @@ func (x *telematicsDataServiceGetRangeDataServer) Send(m *TelematicsDataProto) e
 	return x.ServerStream.SendMsg(m)
 }
 
+func _TelematicsDataService_GetAreaData_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AreaDataRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TelematicsDataServiceServer).GetAreaData(m, &telematicsDataServiceGetAreaDataServer{stream})
+}
+
+type TelematicsDataService_GetAreaDataServer interface {
+	Send(*TelematicsDataProto) error
+	grpc.ServerStream
+}
+
+type telematicsDataServiceGetAreaDataServer struct {
+	grpc.ServerStream
+}
+
+func (x *telematicsDataServiceGetAreaDataServer) Send(m *TelematicsDataProto) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TelematicsDataService_SubscribeTelematics_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TelematicsDataServiceServer).SubscribeTelematics(m, &telematicsDataServiceSubscribeTelematicsServer{stream})
+}
+
+type TelematicsDataService_SubscribeTelematicsServer interface {
+	Send(*TelematicsDataProto) error
+	grpc.ServerStream
+}
+
+type telematicsDataServiceSubscribeTelematicsServer struct {
+	grpc.ServerStream
+}
+
+func (x *telematicsDataServiceSubscribeTelematicsServer) Send(m *TelematicsDataProto) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TelematicsDataService_GetFleetSnapshot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FleetSnapshotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TelematicsDataServiceServer).GetFleetSnapshot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.TelematicsDataService/GetFleetSnapshot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TelematicsDataServiceServer).GetFleetSnapshot(ctx, req.(*FleetSnapshotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TelematicsDataService_GetVehicleLatest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VehicleLatestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TelematicsDataServiceServer).GetVehicleLatest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.TelematicsDataService/GetVehicleLatest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TelematicsDataServiceServer).GetVehicleLatest(ctx, req.(*VehicleLatestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TelematicsDataService_GetFleetAtTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FleetAtTimeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TelematicsDataServiceServer).GetFleetAtTime(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.TelematicsDataService/GetFleetAtTime",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TelematicsDataServiceServer).GetFleetAtTime(ctx, req.(*FleetAtTimeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TelematicsDataService_GetVehicleStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VehicleStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TelematicsDataServiceServer).GetVehicleStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.TelematicsDataService/GetVehicleStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TelematicsDataServiceServer).GetVehicleStats(ctx, req.(*VehicleStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TelematicsDataService_ListTrips_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTripsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TelematicsDataServiceServer).ListTrips(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.TelematicsDataService/ListTrips",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TelematicsDataServiceServer).ListTrips(ctx, req.(*ListTripsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TelematicsDataService_GetTrip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTripRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TelematicsDataServiceServer).GetTrip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.TelematicsDataService/GetTrip",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TelematicsDataServiceServer).GetTrip(ctx, req.(*GetTripRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TelematicsDataService_GetStops_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TelematicsDataServiceServer).GetStops(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.TelematicsDataService/GetStops",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TelematicsDataServiceServer).GetStops(ctx, req.(*StopsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TelematicsDataService_GetHeatmap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HeatmapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TelematicsDataServiceServer).GetHeatmap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.TelematicsDataService/GetHeatmap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TelematicsDataServiceServer).GetHeatmap(ctx, req.(*HeatmapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TelematicsDataService_ServiceDesc is the grpc.ServiceDesc for TelematicsDataService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -158,13 +530,645 @@ var TelematicsDataService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetLatestData",
 			Handler:    _TelematicsDataService_GetLatestData_Handler,
 		},
-	},
-	Streams: []grpc.StreamDesc{
 		{
-			StreamName:    "GetRangeData",
-			Handler:       _TelematicsDataService_GetRangeData_Handler,
-			ServerStreams: true,
+			MethodName: "GetFleetSnapshot",
+			Handler:    _TelematicsDataService_GetFleetSnapshot_Handler,
+		},
+		{
+			MethodName: "GetVehicleLatest",
+			Handler:    _TelematicsDataService_GetVehicleLatest_Handler,
+		},
+		{
+			MethodName: "GetFleetAtTime",
+			Handler:    _TelematicsDataService_GetFleetAtTime_Handler,
+		},
+		{
+			MethodName: "GetVehicleStats",
+			Handler:    _TelematicsDataService_GetVehicleStats_Handler,
+		},
+		{
+			MethodName: "ListTrips",
+			Handler:    _TelematicsDataService_ListTrips_Handler,
+		},
+		{
+			MethodName: "GetTrip",
+			Handler:    _TelematicsDataService_GetTrip_Handler,
+		},
+		{
+			MethodName: "GetStops",
+			Handler:    _TelematicsDataService_GetStops_Handler,
+		},
+		{
+			MethodName: "GetHeatmap",
+			Handler:    _TelematicsDataService_GetHeatmap_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetRangeData",
+			Handler:       _TelematicsDataService_GetRangeData_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetAreaData",
+			Handler:       _TelematicsDataService_GetAreaData_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeTelematics",
+			Handler:       _TelematicsDataService_SubscribeTelematics_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "protobuf/telematics_data.proto",
+}
+
+// ControlServiceClient is the client API for ControlService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ControlServiceClient interface {
+	AddVehicle(ctx context.Context, in *AddVehicleRequest, opts ...grpc.CallOption) (*VehicleStatus, error)
+	RemoveVehicle(ctx context.Context, in *VehicleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	PauseVehicle(ctx context.Context, in *VehicleRequest, opts ...grpc.CallOption) (*VehicleStatus, error)
+	ResumeVehicle(ctx context.Context, in *VehicleRequest, opts ...grpc.CallOption) (*VehicleStatus, error)
+	PauseFleet(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*VehicleList, error)
+	ResumeFleet(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*VehicleList, error)
+	TuneVehicle(ctx context.Context, in *TuneVehicleRequest, opts ...grpc.CallOption) (*VehicleStatus, error)
+	ListVehicles(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*VehicleList, error)
+	GetClock(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ClockState, error)
+	PauseClock(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ClockState, error)
+	ResumeClock(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ClockState, error)
+	SetClockSpeed(ctx context.Context, in *SetClockSpeedRequest, opts ...grpc.CallOption) (*ClockState, error)
+	StepClock(ctx context.Context, in *StepClockRequest, opts ...grpc.CallOption) (*ClockState, error)
+	JumpClock(ctx context.Context, in *JumpClockRequest, opts ...grpc.CallOption) (*ClockState, error)
+	SendCommand(ctx context.Context, in *CommandRequest, opts ...grpc.CallOption) (*CommandReceipt, error)
+}
+
+type controlServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewControlServiceClient(cc grpc.ClientConnInterface) ControlServiceClient {
+	return &controlServiceClient{cc}
+}
+
+func (c *controlServiceClient) AddVehicle(ctx context.Context, in *AddVehicleRequest, opts ...grpc.CallOption) (*VehicleStatus, error) {
+	out := new(VehicleStatus)
+	err := c.cc.Invoke(ctx, "/proto.ControlService/AddVehicle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlServiceClient) RemoveVehicle(ctx context.Context, in *VehicleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/proto.ControlService/RemoveVehicle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlServiceClient) PauseVehicle(ctx context.Context, in *VehicleRequest, opts ...grpc.CallOption) (*VehicleStatus, error) {
+	out := new(VehicleStatus)
+	err := c.cc.Invoke(ctx, "/proto.ControlService/PauseVehicle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlServiceClient) ResumeVehicle(ctx context.Context, in *VehicleRequest, opts ...grpc.CallOption) (*VehicleStatus, error) {
+	out := new(VehicleStatus)
+	err := c.cc.Invoke(ctx, "/proto.ControlService/ResumeVehicle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlServiceClient) PauseFleet(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*VehicleList, error) {
+	out := new(VehicleList)
+	err := c.cc.Invoke(ctx, "/proto.ControlService/PauseFleet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlServiceClient) ResumeFleet(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*VehicleList, error) {
+	out := new(VehicleList)
+	err := c.cc.Invoke(ctx, "/proto.ControlService/ResumeFleet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlServiceClient) TuneVehicle(ctx context.Context, in *TuneVehicleRequest, opts ...grpc.CallOption) (*VehicleStatus, error) {
+	out := new(VehicleStatus)
+	err := c.cc.Invoke(ctx, "/proto.ControlService/TuneVehicle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlServiceClient) ListVehicles(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*VehicleList, error) {
+	out := new(VehicleList)
+	err := c.cc.Invoke(ctx, "/proto.ControlService/ListVehicles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlServiceClient) GetClock(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ClockState, error) {
+	out := new(ClockState)
+	err := c.cc.Invoke(ctx, "/proto.ControlService/GetClock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlServiceClient) PauseClock(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ClockState, error) {
+	out := new(ClockState)
+	err := c.cc.Invoke(ctx, "/proto.ControlService/PauseClock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlServiceClient) ResumeClock(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ClockState, error) {
+	out := new(ClockState)
+	err := c.cc.Invoke(ctx, "/proto.ControlService/ResumeClock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlServiceClient) SetClockSpeed(ctx context.Context, in *SetClockSpeedRequest, opts ...grpc.CallOption) (*ClockState, error) {
+	out := new(ClockState)
+	err := c.cc.Invoke(ctx, "/proto.ControlService/SetClockSpeed", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlServiceClient) StepClock(ctx context.Context, in *StepClockRequest, opts ...grpc.CallOption) (*ClockState, error) {
+	out := new(ClockState)
+	err := c.cc.Invoke(ctx, "/proto.ControlService/StepClock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlServiceClient) JumpClock(ctx context.Context, in *JumpClockRequest, opts ...grpc.CallOption) (*ClockState, error) {
+	out := new(ClockState)
+	err := c.cc.Invoke(ctx, "/proto.ControlService/JumpClock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *controlServiceClient) SendCommand(ctx context.Context, in *CommandRequest, opts ...grpc.CallOption) (*CommandReceipt, error) {
+	out := new(CommandReceipt)
+	err := c.cc.Invoke(ctx, "/proto.ControlService/SendCommand", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ControlServiceServer is the server API for ControlService service.
+// All implementations must embed UnimplementedControlServiceServer
+// for forward compatibility
+type ControlServiceServer interface {
+	AddVehicle(context.Context, *AddVehicleRequest) (*VehicleStatus, error)
+	RemoveVehicle(context.Context, *VehicleRequest) (*emptypb.Empty, error)
+	PauseVehicle(context.Context, *VehicleRequest) (*VehicleStatus, error)
+	ResumeVehicle(context.Context, *VehicleRequest) (*VehicleStatus, error)
+	PauseFleet(context.Context, *emptypb.Empty) (*VehicleList, error)
+	ResumeFleet(context.Context, *emptypb.Empty) (*VehicleList, error)
+	TuneVehicle(context.Context, *TuneVehicleRequest) (*VehicleStatus, error)
+	ListVehicles(context.Context, *emptypb.Empty) (*VehicleList, error)
+	GetClock(context.Context, *emptypb.Empty) (*ClockState, error)
+	PauseClock(context.Context, *emptypb.Empty) (*ClockState, error)
+	ResumeClock(context.Context, *emptypb.Empty) (*ClockState, error)
+	SetClockSpeed(context.Context, *SetClockSpeedRequest) (*ClockState, error)
+	StepClock(context.Context, *StepClockRequest) (*ClockState, error)
+	JumpClock(context.Context, *JumpClockRequest) (*ClockState, error)
+	SendCommand(context.Context, *CommandRequest) (*CommandReceipt, error)
+	mustEmbedUnimplementedControlServiceServer()
+}
+
+// UnimplementedControlServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedControlServiceServer struct {
+}
+
+func (UnimplementedControlServiceServer) AddVehicle(context.Context, *AddVehicleRequest) (*VehicleStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddVehicle not implemented")
+}
+func (UnimplementedControlServiceServer) RemoveVehicle(context.Context, *VehicleRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveVehicle not implemented")
+}
+func (UnimplementedControlServiceServer) PauseVehicle(context.Context, *VehicleRequest) (*VehicleStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseVehicle not implemented")
+}
+func (UnimplementedControlServiceServer) ResumeVehicle(context.Context, *VehicleRequest) (*VehicleStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeVehicle not implemented")
+}
+func (UnimplementedControlServiceServer) PauseFleet(context.Context, *emptypb.Empty) (*VehicleList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseFleet not implemented")
+}
+func (UnimplementedControlServiceServer) ResumeFleet(context.Context, *emptypb.Empty) (*VehicleList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeFleet not implemented")
+}
+func (UnimplementedControlServiceServer) TuneVehicle(context.Context, *TuneVehicleRequest) (*VehicleStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TuneVehicle not implemented")
+}
+func (UnimplementedControlServiceServer) ListVehicles(context.Context, *emptypb.Empty) (*VehicleList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVehicles not implemented")
+}
+func (UnimplementedControlServiceServer) GetClock(context.Context, *emptypb.Empty) (*ClockState, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClock not implemented")
+}
+func (UnimplementedControlServiceServer) PauseClock(context.Context, *emptypb.Empty) (*ClockState, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseClock not implemented")
+}
+func (UnimplementedControlServiceServer) ResumeClock(context.Context, *emptypb.Empty) (*ClockState, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeClock not implemented")
+}
+func (UnimplementedControlServiceServer) SetClockSpeed(context.Context, *SetClockSpeedRequest) (*ClockState, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetClockSpeed not implemented")
+}
+func (UnimplementedControlServiceServer) StepClock(context.Context, *StepClockRequest) (*ClockState, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StepClock not implemented")
+}
+func (UnimplementedControlServiceServer) JumpClock(context.Context, *JumpClockRequest) (*ClockState, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JumpClock not implemented")
+}
+func (UnimplementedControlServiceServer) SendCommand(context.Context, *CommandRequest) (*CommandReceipt, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendCommand not implemented")
+}
+func (UnimplementedControlServiceServer) mustEmbedUnimplementedControlServiceServer() {}
+
+// UnsafeControlServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ControlServiceServer will
+// result in compilation errors.
+type UnsafeControlServiceServer interface {
+	mustEmbedUnimplementedControlServiceServer()
+}
+
+func RegisterControlServiceServer(s grpc.ServiceRegistrar, srv ControlServiceServer) {
+	s.RegisterService(&ControlService_ServiceDesc, srv)
+}
+
+func _ControlService_AddVehicle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddVehicleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServiceServer).AddVehicle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ControlService/AddVehicle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServiceServer).AddVehicle(ctx, req.(*AddVehicleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlService_RemoveVehicle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VehicleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServiceServer).RemoveVehicle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ControlService/RemoveVehicle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServiceServer).RemoveVehicle(ctx, req.(*VehicleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlService_PauseVehicle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VehicleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServiceServer).PauseVehicle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ControlService/PauseVehicle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServiceServer).PauseVehicle(ctx, req.(*VehicleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlService_ResumeVehicle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VehicleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServiceServer).ResumeVehicle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ControlService/ResumeVehicle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServiceServer).ResumeVehicle(ctx, req.(*VehicleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlService_PauseFleet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServiceServer).PauseFleet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ControlService/PauseFleet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServiceServer).PauseFleet(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlService_ResumeFleet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServiceServer).ResumeFleet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ControlService/ResumeFleet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServiceServer).ResumeFleet(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlService_TuneVehicle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TuneVehicleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServiceServer).TuneVehicle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ControlService/TuneVehicle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServiceServer).TuneVehicle(ctx, req.(*TuneVehicleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlService_ListVehicles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServiceServer).ListVehicles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ControlService/ListVehicles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServiceServer).ListVehicles(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlService_GetClock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServiceServer).GetClock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ControlService/GetClock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServiceServer).GetClock(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlService_PauseClock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServiceServer).PauseClock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ControlService/PauseClock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServiceServer).PauseClock(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlService_ResumeClock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServiceServer).ResumeClock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ControlService/ResumeClock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServiceServer).ResumeClock(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlService_SetClockSpeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetClockSpeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServiceServer).SetClockSpeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ControlService/SetClockSpeed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServiceServer).SetClockSpeed(ctx, req.(*SetClockSpeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlService_StepClock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StepClockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServiceServer).StepClock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ControlService/StepClock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServiceServer).StepClock(ctx, req.(*StepClockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlService_JumpClock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JumpClockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServiceServer).JumpClock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ControlService/JumpClock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServiceServer).JumpClock(ctx, req.(*JumpClockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ControlService_SendCommand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommandRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ControlServiceServer).SendCommand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.ControlService/SendCommand",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ControlServiceServer).SendCommand(ctx, req.(*CommandRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ControlService_ServiceDesc is the grpc.ServiceDesc for ControlService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ControlService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.ControlService",
+	HandlerType: (*ControlServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddVehicle",
+			Handler:    _ControlService_AddVehicle_Handler,
+		},
+		{
+			MethodName: "RemoveVehicle",
+			Handler:    _ControlService_RemoveVehicle_Handler,
+		},
+		{
+			MethodName: "PauseVehicle",
+			Handler:    _ControlService_PauseVehicle_Handler,
+		},
+		{
+			MethodName: "ResumeVehicle",
+			Handler:    _ControlService_ResumeVehicle_Handler,
+		},
+		{
+			MethodName: "PauseFleet",
+			Handler:    _ControlService_PauseFleet_Handler,
+		},
+		{
+			MethodName: "ResumeFleet",
+			Handler:    _ControlService_ResumeFleet_Handler,
+		},
+		{
+			MethodName: "TuneVehicle",
+			Handler:    _ControlService_TuneVehicle_Handler,
+		},
+		{
+			MethodName: "ListVehicles",
+			Handler:    _ControlService_ListVehicles_Handler,
+		},
+		{
+			MethodName: "GetClock",
+			Handler:    _ControlService_GetClock_Handler,
+		},
+		{
+			MethodName: "PauseClock",
+			Handler:    _ControlService_PauseClock_Handler,
+		},
+		{
+			MethodName: "ResumeClock",
+			Handler:    _ControlService_ResumeClock_Handler,
+		},
+		{
+			MethodName: "SetClockSpeed",
+			Handler:    _ControlService_SetClockSpeed_Handler,
+		},
+		{
+			MethodName: "StepClock",
+			Handler:    _ControlService_StepClock_Handler,
+		},
+		{
+			MethodName: "JumpClock",
+			Handler:    _ControlService_JumpClock_Handler,
+		},
+		{
+			MethodName: "SendCommand",
+			Handler:    _ControlService_SendCommand_Handler,
 		},
 	},
+	Streams:  []grpc.StreamDesc{},
 	Metadata: "protobuf/telematics_data.proto",
 }